@@ -98,7 +98,7 @@ func (a *ApiService) MailcowCreateExecute(r ApiMailcowCreateRequest) (MailcowRes
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
-	log.Print("[TRACE] MailcowCreateExecute localVarBody: ", localVarBody)
+	log.Print("[TRACE] MailcowCreateExecute localVarBody: ", string(redact(localVarBody)))
 	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
 		return localVarReturnValue, localVarHTTPResponse, err
@@ -10,7 +10,9 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
+	"math/rand"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -18,6 +20,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -33,8 +36,9 @@ var (
 // APIClient manages communication with the mailcow API API v1.0.0
 // In most cases there should be only one, shared, APIClient.
 type APIClient struct {
-	cfg    *Configuration
-	common service // Reuse a single struct instead of allocating one for each service on the heap.
+	cfg      *Configuration
+	common   service // Reuse a single struct instead of allocating one for each service on the heap.
+	throttle *throttle
 
 	Api *ApiService
 }
@@ -53,6 +57,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c := &APIClient{}
 	c.cfg = cfg
 	c.common.client = c
+	c.throttle = newThrottle(cfg.MaxRequestsPerSecond, cfg.MaxConcurrentRequests)
 
 	// API Services
 	c.Api = (*ApiService)(&c.common)
@@ -141,31 +146,145 @@ func parameterToJson(obj interface{}) (string, error) {
 	return string(jsonBuf), err
 }
 
-// callAPI do the request.
+// callAPI do the request, retrying it as configured.
 func (c *APIClient) callAPI(request *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			request.Body = body
+		}
+
+		resp, err := c.doAPI(request)
+
+		retry, retryAfter := c.shouldRetry(request, resp, err)
+		if !retry || attempt >= c.cfg.MaxRetries {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt, retryAfter)
+		if err != nil {
+			log.Printf("[WARN] %s %s failed (%s), retry %d/%d in %s", request.Method, request.URL.Path, err, attempt+1, c.cfg.MaxRetries, wait)
+		} else {
+			log.Printf("[WARN] %s %s failed (%s), retry %d/%d in %s", request.Method, request.URL.Path, resp.Status, attempt+1, c.cfg.MaxRetries, wait)
+			// discard the failed response to reuse the connection
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return nil, request.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// doAPI do a single attempt of the request within the limits of the throttle.
+func (c *APIClient) doAPI(request *http.Request) (*http.Response, error) {
 	if c.cfg.Debug {
 		dump, err := httputil.DumpRequestOut(request, true)
 		if err != nil {
 			return nil, err
 		}
-		log.Printf("\n%s\n", string(dump))
+		log.Printf("[DEBUG] mailcow API request:\n%s\n", redact(dump))
 	}
 
+	release, err := c.throttle.acquire(request.Context())
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.cfg.HTTPClient.Do(request)
 	if err != nil {
+		release()
 		return resp, err
 	}
+	// the request is in flight until its body has been read
+	resp.Body = releaseOnClose{ReadCloser: resp.Body, release: release}
 
 	if c.cfg.Debug {
 		dump, err := httputil.DumpResponse(resp, true)
 		if err != nil {
 			return resp, err
 		}
-		log.Printf("\n%s\n", string(dump))
+		log.Printf("[DEBUG] mailcow API response:\n%s\n", redact(dump))
 	}
 	return resp, err
 }
 
+// isIdempotent reports whether repeating the request has the same effect as sending it once.
+// Reads and edits (which set absolute values) are idempotent, adds and deletes are not.
+func isIdempotent(request *http.Request) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return strings.Contains(request.URL.Path, "/api/v1/edit/")
+}
+
+// shouldRetry decides whether a failed attempt is retried and returns the wait requested by the server.
+func (c *APIClient) shouldRetry(request *http.Request, resp *http.Response, err error) (bool, time.Duration) {
+	if request.Context().Err() != nil {
+		return false, 0
+	}
+	if err != nil {
+		if isIdempotent(request) {
+			return true, 0
+		}
+		// the request did not reach mailcow if the connection could not be established
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial", 0
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// mailcow did not process the request
+		return true, parseRetryAfter(resp.Header.Get("Retry-After"))
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(request), parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+	return false, 0
+}
+
+// backoff returns the wait before the next attempt: exponential with jitter, or what the server asked for.
+// Both are capped by RetryWaitMax, so a proxy asking for hours does not block the run.
+func (c *APIClient) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if c.cfg.RetryWaitMax > 0 {
+			return min(retryAfter, c.cfg.RetryWaitMax)
+		}
+		return retryAfter
+	}
+	wait := c.cfg.RetryWaitMin << uint(attempt)
+	if wait <= 0 || (c.cfg.RetryWaitMax > 0 && wait > c.cfg.RetryWaitMax) {
+		wait = c.cfg.RetryWaitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// wait between half and the full backoff, so parallel requests do not retry in lockstep
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// parseRetryAfter parses the Retry-After header given in seconds or as HTTP date.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
 // Allow modification of underlying config for alternate implementations and testing
 // Caution: modifying the configuration while live can cause data races and potentially unwanted behavior
 func (c *APIClient) GetConfig() *Configuration {
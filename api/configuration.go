@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // contextKeys are used to identify the type of value in the context.
@@ -85,6 +86,16 @@ type Configuration struct {
 	Servers          ServerConfigurations
 	OperationServers map[string]ServerConfigurations
 	HTTPClient       *http.Client
+	// MaxRetries is the number of retries of a failed request, 0 disables retries
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// RetryWaitMin is the wait before the first retry, doubled on every further retry
+	RetryWaitMin time.Duration `json:"retryWaitMin,omitempty"`
+	// RetryWaitMax caps the wait between two retries
+	RetryWaitMax time.Duration `json:"retryWaitMax,omitempty"`
+	// MaxRequestsPerSecond limits the rate of requests of all services, 0 is unlimited
+	MaxRequestsPerSecond float64 `json:"maxRequestsPerSecond,omitempty"`
+	// MaxConcurrentRequests limits the requests in flight of all services, 0 is unlimited
+	MaxConcurrentRequests int `json:"maxConcurrentRequests,omitempty"`
 }
 
 // NewConfiguration returns a new Configuration object
@@ -100,6 +111,9 @@ func NewConfiguration() *Configuration {
 			},
 		},
 		OperationServers: map[string]ServerConfigurations{},
+		MaxRetries:       3,
+		RetryWaitMin:     1 * time.Second,
+		RetryWaitMax:     30 * time.Second,
 	}
 	return cfg
 }
@@ -89,6 +89,94 @@ func NewCreateIdentityProviderKeycloakRequest() *MailcowCreateRequest {
 	return &this
 }
 
+func NewCreateAppPasswordRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/app-passwd"
+	this.ResourceName = "resourceAppPassword"
+	return &this
+}
+
+func NewCreateBccMapRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/bcc"
+	this.ResourceName = "resourceBccMap"
+	return &this
+}
+
+func NewCreateRecipientMapRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/recipient_map"
+	this.ResourceName = "resourceRecipientMap"
+	return &this
+}
+
+func NewCreateRelayhostRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/relayhost"
+	this.ResourceName = "resourceRelayhost"
+	return &this
+}
+
+func NewCreateTransportRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/transport"
+	this.ResourceName = "resourceTransport"
+	return &this
+}
+
+func NewCreateTlsPolicyMapRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/tls-policy-map"
+	this.ResourceName = "resourceTlsPolicyMap"
+	return &this
+}
+
+func NewCreateDomainPolicyRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/domain-policy"
+	this.ResourceName = "resourceDomainPolicy"
+	return &this
+}
+
+func NewCreateMailboxPolicyRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/mailbox-policy"
+	this.ResourceName = "resourceMailboxPolicy"
+	return &this
+}
+
+func NewCreateSieveFilterRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/filter"
+	this.ResourceName = "resourceSieveFilter"
+	return &this
+}
+
+func NewCreateResourceRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/resource"
+	this.ResourceName = "resourceResource"
+	return &this
+}
+
+func NewCreateTimeLimitedAliasRequest() *MailcowCreateRequest {
+	this := MailcowCreateRequest{}
+	this.payload = make(map[string]interface{})
+	this.endpoint = "/api/v1/add/time_limited_alias"
+	this.ResourceName = "resourceTimeLimitedAlias"
+	return &this
+}
+
 func (o *MailcowCreateRequest) Get(key string) interface{} {
 	if !o.Has(key) {
 		var ret bool
@@ -121,7 +209,7 @@ func (o *MailcowCreateRequest) Set(key string, value interface{}) {
 			setValue = 0
 		}
 	}
-	log.Print("[TRACE] CreateRequest Set key: ", key, ", value: ", setValue)
+	log.Print("[TRACE] CreateRequest Set key: ", key, ", value: ", redactValue(key, setValue))
 	o.payload[key] = &setValue
 }
 
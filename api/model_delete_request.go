@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"net/url"
 )
 
 type MailcowDeleteRequest struct {
@@ -64,7 +65,7 @@ func NewDeleteOAuth2ClientRequest() *MailcowDeleteRequest {
 func NewDeleteDomainAdminRequest() *MailcowDeleteRequest {
 	this := MailcowDeleteRequest{}
 	this.endpoint = "/api/v1/delete/domain-admin"
-	this.ResourceName = "resourceDomainadmin"
+	this.ResourceName = "resourceDomainAdmin"
 	return &this
 }
 
@@ -75,6 +76,91 @@ func NewDeleteIdentityProviderKeycloakRequest() *MailcowDeleteRequest {
 	return &this
 }
 
+func NewDeleteAppPasswordRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/app-passwd"
+	this.ResourceName = "resourceAppPassword"
+	return &this
+}
+
+func NewDeleteBccMapRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/bcc"
+	this.ResourceName = "resourceBccMap"
+	return &this
+}
+
+func NewDeleteRecipientMapRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/recipient_map"
+	this.ResourceName = "resourceRecipientMap"
+	return &this
+}
+
+func NewDeleteRelayhostRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/relayhost"
+	this.ResourceName = "resourceRelayhost"
+	return &this
+}
+
+func NewDeleteTransportRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/transport"
+	this.ResourceName = "resourceTransport"
+	return &this
+}
+
+func NewDeleteTlsPolicyMapRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/tls-policy-map"
+	this.ResourceName = "resourceTlsPolicyMap"
+	return &this
+}
+
+func NewDeleteDomainPolicyRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/domain-policy"
+	this.ResourceName = "resourceDomainPolicy"
+	return &this
+}
+
+func NewDeleteMailboxPolicyRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/mailbox-policy"
+	this.ResourceName = "resourceMailboxPolicy"
+	return &this
+}
+
+func NewDeleteSieveFilterRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/filter"
+	this.ResourceName = "resourceSieveFilter"
+	return &this
+}
+
+func NewDeleteResourceRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/resource"
+	this.ResourceName = "resourceResource"
+	return &this
+}
+
+func NewDeleteTimeLimitedAliasRequest() *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/time_limited_alias"
+	this.ResourceName = "resourceTimeLimitedAlias"
+	return &this
+}
+
+// NewDeleteMailboxTagRequest removes tags of the mailbox, the item is the tag
+func NewDeleteMailboxTagRequest(mailbox string) *MailcowDeleteRequest {
+	this := MailcowDeleteRequest{}
+	this.endpoint = "/api/v1/delete/mailbox/tag/" + url.PathEscape(mailbox)
+	this.ResourceName = "resourceMailbox"
+	return &this
+}
+
 func (o *MailcowDeleteRequest) GetItem() *string {
 	log.Print("[TRACE] GetItem")
 	if !o.HasItem() {
@@ -1,6 +1,9 @@
 package api
 
-import "context"
+import (
+	"context"
+	"net/url"
+)
 
 func (a *ApiService) MailcowGetAlias(ctx context.Context, id string) ApiMailcowGetRequest {
 	return ApiMailcowGetRequest{
@@ -11,6 +14,14 @@ func (a *ApiService) MailcowGetAlias(ctx context.Context, id string) ApiMailcowG
 	}
 }
 
+func (a *ApiService) MailcowGetAliases(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/alias/all",
+	}
+}
+
 func (a *ApiService) MailcowGetAliasDomain(ctx context.Context, id string) ApiMailcowGetRequest {
 	return ApiMailcowGetRequest{
 		ApiService: a,
@@ -29,6 +40,14 @@ func (a *ApiService) MailcowGetDomain(ctx context.Context, id string) ApiMailcow
 	}
 }
 
+func (a *ApiService) MailcowGetDomains(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/domain/all",
+	}
+}
+
 func (a *ApiService) MailcowGetMailbox(ctx context.Context, id string) ApiMailcowGetRequest {
 	return ApiMailcowGetRequest{
 		ApiService: a,
@@ -38,6 +57,19 @@ func (a *ApiService) MailcowGetMailbox(ctx context.Context, id string) ApiMailco
 	}
 }
 
+// MailcowGetMailboxes returns all mailboxes, only those of the domain if it is not empty
+func (a *ApiService) MailcowGetMailboxes(ctx context.Context, domain string) ApiMailcowGetAllRequest {
+	endpoint := "/api/v1/get/mailbox/all"
+	if domain != "" {
+		endpoint += "/" + url.PathEscape(domain)
+	}
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   endpoint,
+	}
+}
+
 func (a *ApiService) MailcowGetDkim(ctx context.Context, id string) ApiMailcowGetRequest {
 	return ApiMailcowGetRequest{
 		ApiService: a,
@@ -56,6 +88,15 @@ func (a *ApiService) MailcowGetSyncjob(ctx context.Context, id string) ApiMailco
 	}
 }
 
+// MailcowGetSyncjobs returns all sync jobs without their logs
+func (a *ApiService) MailcowGetSyncjobs(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/syncjobs/all/no_log",
+	}
+}
+
 func (a *ApiService) MailcowGetOAuth2Client(ctx context.Context, id string) ApiMailcowGetRequest {
 	return ApiMailcowGetRequest{
 		ApiService: a,
@@ -77,7 +118,7 @@ func (a *ApiService) MailcowGetDomainAdmin(ctx context.Context, id string) ApiMa
 	return ApiMailcowGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		endpoint:   "/api/v1/get/alias/{id}",
+		endpoint:   "/api/v1/get/domain-admin/{id}",
 		id:         id,
 	}
 }
@@ -89,3 +130,166 @@ func (a *ApiService) MailcowGetIdentityProviderKeycloak(ctx context.Context) Api
 		endpoint:   "/api/v1/get/identity-provider",
 	}
 }
+
+// MailcowGetAppPasswords returns all app passwords of the mailbox
+func (a *ApiService) MailcowGetAppPasswords(ctx context.Context, mailbox string) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/app-passwd/all/" + url.PathEscape(mailbox),
+	}
+}
+
+func (a *ApiService) MailcowGetBccMap(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/bcc/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetBccMaps(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/bcc/all",
+	}
+}
+
+func (a *ApiService) MailcowGetRecipientMap(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/recipient_map/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetRecipientMaps(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/recipient_map/all",
+	}
+}
+
+func (a *ApiService) MailcowGetRelayhost(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/relayhost/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetRelayhosts(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/relayhost/all",
+	}
+}
+
+func (a *ApiService) MailcowGetTransport(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/transport/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetTransports(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/transport/all",
+	}
+}
+
+func (a *ApiService) MailcowGetTlsPolicyMap(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/tls-policy-map/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetTlsPolicyMaps(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/tls-policy-map/all",
+	}
+}
+
+// MailcowGetDomainPolicies returns the entries of the spam policy list (wl or bl) of the domain
+func (a *ApiService) MailcowGetDomainPolicies(ctx context.Context, list string, domain string) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/policy_" + list + "_domain/" + url.PathEscape(domain),
+	}
+}
+
+// MailcowGetMailboxPolicies returns the entries of the spam policy list (wl or bl) of the mailbox
+func (a *ApiService) MailcowGetMailboxPolicies(ctx context.Context, list string, mailbox string) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/policy_" + list + "_mailbox/" + url.PathEscape(mailbox),
+	}
+}
+
+func (a *ApiService) MailcowGetSieveFilters(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/filters/all",
+	}
+}
+
+func (a *ApiService) MailcowGetResources(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/resource/all",
+	}
+}
+
+// MailcowGetTimeLimitedAliases returns the time-limited aliases of the mailbox
+func (a *ApiService) MailcowGetTimeLimitedAliases(ctx context.Context, mailbox string) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/time_limited_aliases/" + url.PathEscape(mailbox),
+	}
+}
+
+func (a *ApiService) MailcowGetRateLimitMailbox(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/rl-mbox/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetRateLimitDomain(ctx context.Context, id string) ApiMailcowGetRequest {
+	return ApiMailcowGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/rl-domain/{id}",
+		id:         id,
+	}
+}
+
+func (a *ApiService) MailcowGetAliasDomains(ctx context.Context) ApiMailcowGetAllRequest {
+	return ApiMailcowGetAllRequest{
+		ApiService: a,
+		ctx:        ctx,
+		endpoint:   "/api/v1/get/alias-domain/all",
+	}
+}
@@ -68,6 +68,15 @@ func NewUpdateDomainAdminRequest() *MailcowUpdateRequest {
 	return &this
 }
 
+func NewUpdateDomainAdminAclRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/da-acl"
+	this.ResourceName = "resourceDomainAdmin"
+	return &this
+}
+
 func NewUpdateIdentityProviderKeycloakRequest() *MailcowUpdateRequest {
 	this := MailcowUpdateRequest{}
 	this.attr = make(map[string]interface{})
@@ -77,6 +86,123 @@ func NewUpdateIdentityProviderKeycloakRequest() *MailcowUpdateRequest {
 	return &this
 }
 
+func NewUpdateAppPasswordRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/app-passwd"
+	this.ResourceName = "resourceAppPassword"
+	return &this
+}
+
+func NewUpdateBccMapRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/bcc"
+	this.ResourceName = "resourceBccMap"
+	return &this
+}
+
+func NewUpdateRecipientMapRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/recipient_map"
+	this.ResourceName = "resourceRecipientMap"
+	return &this
+}
+
+func NewUpdateRelayhostRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/relayhost"
+	this.ResourceName = "resourceRelayhost"
+	return &this
+}
+
+func NewUpdateTransportRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/transport"
+	this.ResourceName = "resourceTransport"
+	return &this
+}
+
+func NewUpdateTlsPolicyMapRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/tls-policy-map"
+	this.ResourceName = "resourceTlsPolicyMap"
+	return &this
+}
+
+func NewUpdateSieveFilterRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/filter"
+	this.ResourceName = "resourceSieveFilter"
+	return &this
+}
+
+func NewUpdateResourceRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/resource"
+	this.ResourceName = "resourceResource"
+	return &this
+}
+
+func NewUpdateRateLimitMailboxRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/rl-mbox"
+	this.ResourceName = "resourceRateLimit"
+	return &this
+}
+
+func NewUpdateRateLimitDomainRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/rl-domain"
+	this.ResourceName = "resourceRateLimit"
+	return &this
+}
+
+func NewUpdateQuarantineNotificationRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/quarantine_notification"
+	this.ResourceName = "resourceMailbox"
+	return &this
+}
+
+func NewUpdateQuarantineCategoryRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/quarantine_category"
+	this.ResourceName = "resourceMailbox"
+	return &this
+}
+
+func NewUpdateMailboxCustomAttributeRequest() *MailcowUpdateRequest {
+	this := MailcowUpdateRequest{}
+	this.attr = make(map[string]interface{})
+	this.items = make([]string, 1)
+	this.endpoint = "/api/v1/edit/mailbox/custom-attribute"
+	this.ResourceName = "resourceMailbox"
+	return &this
+}
+
 func (o *MailcowUpdateRequest) DeleteAttr(key string) {
 	log.Print("[TRACE] UpdateRequest Delete attr: ", key)
 	delete(o.attr, key)
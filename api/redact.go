@@ -0,0 +1,41 @@
+package api
+
+import (
+	"regexp"
+	"strings"
+)
+
+const redacted = "***"
+
+// secretFields are the secret fields of mailboxes, syncjobs, domain admins, app passwords, OAuth2 clients and identity providers
+var secretFields = []string{
+	"password",
+	"password1",
+	"password2",
+	"app_passwd",
+	"app_passwd2",
+	"client_secret",
+}
+
+var (
+	// secretHeaderPattern matches the headers carrying credentials in a dumped request or response
+	secretHeaderPattern = regexp.MustCompile(`(?im)^(X-Api-Key|Authorization|Cookie|Set-Cookie):[^\r\n]*`)
+	// secretFieldPattern matches the JSON string values of the secret fields
+	secretFieldPattern = regexp.MustCompile(`"(` + strings.Join(secretFields, "|") + `)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+)
+
+// redact masks API keys, passwords and client secrets in a dumped request or response, so it can be logged.
+func redact(dump []byte) []byte {
+	dump = secretHeaderPattern.ReplaceAll(dump, []byte("$1: "+redacted))
+	return secretFieldPattern.ReplaceAll(dump, []byte(`"$1":"`+redacted+`"`))
+}
+
+// redactValue masks the value of a secret field, so it can be logged.
+func redactValue(key string, value interface{}) interface{} {
+	for _, secretField := range secretFields {
+		if key == secretField {
+			return redacted
+		}
+	}
+	return value
+}
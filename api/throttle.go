@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// throttle limits the rate and the concurrency of the requests sent by an APIClient.
+type throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+
+	slots chan struct{}
+}
+
+// newThrottle returns a throttle allowing requestsPerSecond evenly spaced requests
+// and maxConcurrent requests in flight, 0 disables the respective limit.
+func newThrottle(requestsPerSecond float64, maxConcurrent int) *throttle {
+	t := &throttle{}
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+// acquire blocks until a request may be sent, the returned release has to be called when it is done.
+func (t *throttle) acquire(ctx context.Context) (func(), error) {
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	}
+
+	if wait := t.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	var once sync.Once
+	return func() { once.Do(release) }, nil
+}
+
+// reserve books the next start time and returns how long to wait for it.
+func (t *throttle) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+	return wait
+}
+
+// releaseOnClose releases the throttle slot when the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
@@ -0,0 +1,4 @@
+data "mailcow_aliases" "aliases" {
+  domain = "440044.xyz"
+  goto   = "demo@440044.xyz"
+}
@@ -0,0 +1,4 @@
+data "mailcow_domains" "primary" {
+  active   = true
+  backupmx = false
+}
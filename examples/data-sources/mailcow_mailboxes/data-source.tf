@@ -0,0 +1,5 @@
+data "mailcow_mailboxes" "mailboxes" {
+  domain           = "440044.xyz"
+  active           = true
+  local_part_regex = "^demo"
+}
@@ -0,0 +1,3 @@
+data "mailcow_resource" "meeting_room" {
+  name = "MeetingRoom@440044.xyz"
+}
@@ -0,0 +1,11 @@
+data "mailcow_syncjob_status" "migration" {
+  syncjob_id = mailcow_syncjob.migration.id
+  username   = mailcow_syncjob.migration.username
+
+  lifecycle {
+    postcondition {
+      condition     = self.last_run == "" || self.errors == 0
+      error_message = "the last run of the migration had ${self.errors} errors"
+    }
+  }
+}
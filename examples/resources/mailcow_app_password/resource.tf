@@ -0,0 +1,17 @@
+resource "mailcow_mailbox" "demo" {
+  domain     = "440044.xyz"
+  local_part = "demo"
+  full_name  = "Demo User"
+  password   = "secret-password"
+}
+
+resource "mailcow_app_password" "ci" {
+  mailbox   = mailcow_mailbox.demo.address
+  app_name  = "ci"
+  protocols = ["imap", "smtp"]
+}
+
+output "ci_password" {
+  value     = mailcow_app_password.ci.password
+  sensitive = true
+}
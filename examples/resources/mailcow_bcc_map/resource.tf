@@ -0,0 +1,11 @@
+resource "mailcow_bcc_map" "archive_sent" {
+  local_dest = "440044.xyz"
+  bcc_dest   = "archive@440044.xyz"
+  type       = "sender"
+}
+
+resource "mailcow_bcc_map" "archive_received" {
+  local_dest = "440044.xyz"
+  bcc_dest   = "archive@440044.xyz"
+  type       = "rcpt"
+}
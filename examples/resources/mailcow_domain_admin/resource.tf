@@ -0,0 +1,10 @@
+resource "mailcow_domain" "demo" {
+  domain = "440044.xyz"
+}
+
+resource "mailcow_domain_admin" "demo" {
+  username = "demo-admin"
+  password = "secret-password"
+  domains  = [mailcow_domain.demo.domain]
+  acl      = ["syncjobs", "quarantine", "filters"]
+}
@@ -0,0 +1,5 @@
+resource "mailcow_domain_policy" "domain" {
+  domain = mailcow_domain.domain.domain
+  wl     = ["*@partner.xyz", "newsletter@example.org"]
+  bl     = ["*@spam.xyz"]
+}
@@ -0,0 +1,5 @@
+resource "mailcow_mailbox_policy" "demo" {
+  mailbox = mailcow_mailbox.demo.address
+  wl      = ["*@partner.xyz"]
+  bl      = ["*@spam.xyz", "offers@shop.xyz"]
+}
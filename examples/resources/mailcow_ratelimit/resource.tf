@@ -0,0 +1,4 @@
+resource "mailcow_ratelimit" "newsletter" {
+  mailbox    = mailcow_mailbox.newsletter.address
+  rate_limit = "500h"
+}
@@ -0,0 +1,9 @@
+resource "mailcow_recipient_map" "migration" {
+  recipient_map_old = "old-domain.xyz"
+  recipient_map_new = "440044.xyz"
+}
+
+resource "mailcow_recipient_map" "info" {
+  recipient_map_old = "info@440044.xyz"
+  recipient_map_new = "demo@440044.xyz"
+}
@@ -0,0 +1,10 @@
+resource "mailcow_relayhost" "smarthost" {
+  hostname = "[smtp.example.org]:587"
+  username = "relay@440044.xyz"
+  password = var.relay_password
+}
+
+resource "mailcow_domain" "domain" {
+  domain    = "440044.xyz"
+  relayhost = mailcow_relayhost.smarthost.id
+}
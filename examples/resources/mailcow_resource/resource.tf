@@ -0,0 +1,13 @@
+resource "mailcow_resource" "meeting_room" {
+  description       = "Meeting Room"
+  domain            = mailcow_domain.domain.domain
+  kind              = "location"
+  multiple_bookings = 0
+}
+
+resource "mailcow_resource" "projector" {
+  description       = "Projector"
+  domain            = mailcow_domain.domain.domain
+  kind              = "thing"
+  multiple_bookings = -1
+}
@@ -0,0 +1,12 @@
+resource "mailcow_sieve_filter" "junk" {
+  username    = mailcow_mailbox.demo.address
+  filter_type = "prefilter"
+  script_desc = "move spam to junk"
+  script_data = <<-EOT
+    require "fileinto";
+    if header :contains "X-Spam-Flag" "YES" {
+      fileinto "Junk";
+      stop;
+    }
+  EOT
+}
@@ -0,0 +1,9 @@
+resource "mailcow_time_limited_alias" "newsletter" {
+  mailbox     = mailcow_mailbox.demo.address
+  validity    = 168
+  description = "newsletter signup"
+}
+
+output "newsletter_address" {
+  value = mailcow_time_limited_alias.newsletter.address
+}
@@ -0,0 +1,10 @@
+resource "mailcow_tls_policy_map" "partner" {
+  dest       = "partner.xyz"
+  policy     = "secure"
+  parameters = "match=.partner.xyz protocols=>=TLSv1.2"
+}
+
+resource "mailcow_tls_policy_map" "dane" {
+  dest   = "dane.xyz"
+  policy = "dane-only"
+}
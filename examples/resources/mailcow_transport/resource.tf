@@ -0,0 +1,6 @@
+resource "mailcow_transport" "outlook" {
+  destination = "outlook.com"
+  nexthop     = "[smtp.example.org]:587"
+  username    = "relay@440044.xyz"
+  password    = var.relay_password
+}
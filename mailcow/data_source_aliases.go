@@ -0,0 +1,139 @@
+package mailcow
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAliases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAliasesRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "only list the aliases of this domain",
+				Optional:    true,
+			},
+			"goto": {
+				Type:        schema.TypeString,
+				Description: `only list the aliases with this destination address among their destinations, including the special values "ham@localhost", "spam@localhost" and "null@localhost"`,
+				Optional:    true,
+			},
+			"aliases": {
+				Type:        schema.TypeList,
+				Description: "the aliases matching the filters",
+				Computed:    true,
+				Elem:        dataSourceAliasesElem(),
+			},
+		},
+	}
+}
+
+func dataSourceAliasesElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "alias id",
+				Computed:    true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "alias address, \"@domain.tld\" for catchall",
+				Computed:    true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "domain name",
+				Computed:    true,
+			},
+			"goto": {
+				Type:        schema.TypeString,
+				Description: `destination address, comma separated. Special values are "ham@localhost", "spam@localhost" and "null@localhost".`,
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is alias active or not",
+				Computed:    true,
+			},
+			"sogo_visible": {
+				Type:        schema.TypeBool,
+				Description: "visibility as selectable sender in SOGo",
+				Computed:    true,
+			},
+			"private_comment": {
+				Type:        schema.TypeString,
+				Description: "private comment",
+				Computed:    true,
+			},
+			"public_comment": {
+				Type:        schema.TypeString,
+				Description: "public comment",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceAliasesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*APIClient)
+	domain := d.Get("domain").(string)
+	gotoAddress := d.Get("goto").(string)
+
+	request := c.client.Api.MailcowGetAliases(ctx)
+
+	aliases, err := readAllRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	elem := dataSourceAliasesElem()
+	result := make([]interface{}, 0, len(aliases))
+	for _, alias := range aliases {
+		// mailcow returns no comments instead of empty ones
+		for _, comment := range []string{"private_comment", "public_comment"} {
+			if alias[comment] == nil {
+				alias[comment] = ""
+			}
+		}
+		flattened, err := flattenResourceData(elem, &alias, nil)
+		if err != nil {
+			return diag.Errorf("alias '%s': %s", alias["address"], err)
+		}
+		if domain != "" && flattened["domain"] != domain {
+			continue
+		}
+		if gotoAddress != "" && !aliasHasGoto(flattened["goto"].(string), gotoAddress) {
+			continue
+		}
+		result = append(result, flattened)
+	}
+
+	err = d.Set("aliases", result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if domain == "" {
+		d.SetId("all")
+	} else {
+		d.SetId(domain)
+	}
+
+	return diags
+}
+
+// aliasHasGoto reports whether the address is one of the comma separated destinations
+func aliasHasGoto(destinations string, address string) bool {
+	for _, destination := range strings.Split(destinations, ",") {
+		if strings.TrimSpace(destination) == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAliases(t *testing.T) {
+	domain := fmt.Sprintf("with-ds-aliases-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAliases(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailcow_aliases.domain", "aliases.#", "2"),
+					resource.TestCheckResourceAttr("data.mailcow_aliases.spam", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("data.mailcow_aliases.spam", "aliases.0.address", "spam@"+domain),
+					resource.TestCheckResourceAttr("data.mailcow_aliases.spam", "aliases.0.goto", "spam@localhost"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAliases(domain string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_alias" "info" {
+  address = "info@${mailcow_domain.domain.id}"
+  goto    = "demo@440044.xyz"
+}
+
+resource "mailcow_alias" "spam" {
+  address = "spam@${mailcow_domain.domain.id}"
+  goto    = "spam@localhost"
+}
+
+data "mailcow_aliases" "domain" {
+  domain     = mailcow_domain.domain.id
+  depends_on = [mailcow_alias.info, mailcow_alias.spam]
+}
+
+data "mailcow_aliases" "spam" {
+  domain     = mailcow_domain.domain.id
+  goto       = "spam@localhost"
+  depends_on = [mailcow_alias.info, mailcow_alias.spam]
+}
+`, domain)
+}
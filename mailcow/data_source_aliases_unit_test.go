@@ -0,0 +1,71 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestDataSourceAliasesMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+
+	for _, alias := range []map[string]interface{}{
+		{"address": "info@440044.xyz", "goto": "demo@440044.xyz,admin@440044.xyz", "public_comment": "info"},
+		{"address": "spam@440044.xyz", "goto": gotoSpamDestination},
+		{"address": "@550055.xyz", "goto": "demo@440044.xyz", "active": false, "sogo_visible": true},
+	} {
+		testMockApply(t, resourceAlias(), nil, alias, meta)
+	}
+
+	testCases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			name:   "all",
+			config: map[string]interface{}{},
+			expected: map[string]string{
+				"aliases.#":                "3",
+				"aliases.0.id":             "1",
+				"aliases.0.address":        "info@440044.xyz",
+				"aliases.0.domain":         "440044.xyz",
+				"aliases.0.active":         "true",
+				"aliases.0.public_comment": "info",
+			},
+		},
+		{
+			name:   "domain",
+			config: map[string]interface{}{"domain": "550055.xyz"},
+			expected: map[string]string{
+				"aliases.#":              "1",
+				"aliases.0.address":      "@550055.xyz",
+				"aliases.0.active":       "false",
+				"aliases.0.sogo_visible": "true",
+			},
+		},
+		{
+			name:   "goto",
+			config: map[string]interface{}{"goto": "demo@440044.xyz"},
+			expected: map[string]string{
+				"aliases.#":         "2",
+				"aliases.0.address": "info@440044.xyz",
+				"aliases.1.address": "@550055.xyz",
+			},
+		},
+		{
+			name:   "special goto",
+			config: map[string]interface{}{"goto": gotoSpamDestination},
+			expected: map[string]string{
+				"aliases.#":         "1",
+				"aliases.0.address": "spam@440044.xyz",
+				"aliases.0.goto":    gotoSpamDestination,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := testMockReadData(t, dataSourceAliases(), tc.config, meta)
+			testMockCheckAttrs(t, state, tc.expected)
+		})
+	}
+}
@@ -3,8 +3,6 @@ package mailcow
 import (
 	"context"
 	"errors"
-	"fmt"
-	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -130,24 +128,7 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(errors.New("domain not found: " + id))
 	}
 
-	domain["aliases"] = domain["max_num_aliases_for_domain"]
-	domain["defquota"] = int(domain["def_new_mailbox_quota"].(float64)) / (1024 * 1024)
-	domain["domain"] = domain["domain_name"]
-	domain["maxquota"] = int(domain["max_quota_for_mbox"].(float64)) / (1024 * 1024)
-	domain["mailboxes"] = domain["max_num_mboxes_for_domain"]
-	domain["maxquota"] = int(domain["max_quota_for_mbox"].(float64)) / (1024 * 1024)
-	domain["quota"] = int(domain["max_quota_for_domain"].(float64)) / (1024 * 1024)
-	domainRl := domain["rl"]
-	if domainRl != nil {
-		if reflect.ValueOf(domainRl).Kind() != reflect.Bool {
-			rl := make(map[string]string)
-			value := reflect.ValueOf(domainRl)
-			for _, key := range value.MapKeys() {
-				rl[fmt.Sprint(key)] = fmt.Sprint(value.MapIndex(key))
-			}
-			domain["rate_limit"] = rl["value"] + rl["frame"]
-		}
-	}
+	dataSourceDomainMap(domain)
 
 	//exclude := []string{"tags"}
 	err = setResourceData(dataSourceDomain(), d, &domain, nil, nil)
@@ -178,3 +159,14 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	return diags
 }
+
+// dataSourceDomainMap maps the fields of a domain returned by mailcow to the arguments of the data source
+func dataSourceDomainMap(domain map[string]interface{}) {
+	domain["aliases"] = domain["max_num_aliases_for_domain"]
+	domain["defquota"] = int(domain["def_new_mailbox_quota"].(float64)) / (1024 * 1024)
+	domain["domain"] = domain["domain_name"]
+	domain["mailboxes"] = domain["max_num_mboxes_for_domain"]
+	domain["maxquota"] = int(domain["max_quota_for_mbox"].(float64)) / (1024 * 1024)
+	domain["quota"] = int(domain["max_quota_for_domain"].(float64)) / (1024 * 1024)
+	domain["rate_limit"] = rateLimitString(domain["rl"])
+}
@@ -0,0 +1,91 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsRead,
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "only list active (true) or inactive (false) domains",
+				Optional:    true,
+			},
+			"backupmx": {
+				Type:        schema.TypeBool,
+				Description: "only list backup MX (true) or primary (false) domains",
+				Optional:    true,
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Description: "the domains matching the filters",
+				Computed:    true,
+				Elem:        dataSourceDomainsElem(),
+			},
+		},
+	}
+}
+
+// dataSourceDomainsElem has the attributes of the data source mailcow_domain
+func dataSourceDomainsElem() *schema.Resource {
+	elem := dataSourceDomain().Schema
+	elem["domain"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Fully qualified domain name",
+		Computed:    true,
+	}
+	elem["tags"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "tags of the domain",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	return &schema.Resource{Schema: elem}
+}
+
+func dataSourceDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*APIClient)
+
+	request := c.client.Api.MailcowGetDomains(ctx)
+
+	domains, err := readAllRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// GetOkExists distinguishes false from no filter
+	active, filterActive := d.GetOkExists("active")
+	backupmx, filterBackupmx := d.GetOkExists("backupmx")
+
+	elem := dataSourceDomainsElem()
+	result := make([]interface{}, 0, len(domains))
+	for _, domain := range domains {
+		dataSourceDomainMap(domain)
+		flattened, err := flattenResourceData(elem, &domain, nil)
+		if err != nil {
+			return diag.Errorf("domain '%s': %s", domain["domain_name"], err)
+		}
+		if filterActive && flattened["active"] != active {
+			continue
+		}
+		if filterBackupmx && flattened["backupmx"] != backupmx {
+			continue
+		}
+		result = append(result, flattened)
+	}
+
+	err = d.Set("domains", result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("all")
+
+	return diags
+}
@@ -0,0 +1,41 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDomains(t *testing.T) {
+	domain := fmt.Sprintf("with-ds-domains-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDomains(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.mailcow_domains.inactive", "domains.0.domain", "mailcow_domain.domain", "domain"),
+					resource.TestCheckResourceAttr("data.mailcow_domains.inactive", "domains.0.active", "false"),
+					resource.TestCheckResourceAttr("data.mailcow_domains.inactive", "domains.0.quota", "20480"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDomains(domain string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+  quota  = 20480
+  active = false
+}
+
+data "mailcow_domains" "inactive" {
+  active     = false
+  depends_on = [mailcow_domain.domain]
+}
+`, domain)
+}
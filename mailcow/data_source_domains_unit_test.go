@@ -0,0 +1,71 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestDataSourceDomainsMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+
+	for _, domain := range []map[string]interface{}{
+		{"domain": "440044.xyz", "quota": 20480},
+		{"domain": "550055.xyz", "backupmx": true},
+		{"domain": "660066.xyz", "active": false},
+	} {
+		testMockApply(t, resourceDomain(), nil, domain, meta)
+	}
+	// the resource does not manage tags
+	mock.get("domain", "440044.xyz")["tags"] = []interface{}{"demo"}
+
+	testCases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			name:   "all",
+			config: map[string]interface{}{},
+			expected: map[string]string{
+				"domains.#":             "3",
+				"domains.0.domain":      "440044.xyz",
+				"domains.0.quota":       "20480",
+				"domains.0.bytes_total": "0",
+				"domains.0.rate_limit":  "10s",
+				"domains.0.tags.#":      "1",
+				"domains.0.tags.0":      "demo",
+			},
+		},
+		{
+			name:   "backupmx",
+			config: map[string]interface{}{"backupmx": true},
+			expected: map[string]string{
+				"domains.#":        "1",
+				"domains.0.domain": "550055.xyz",
+			},
+		},
+		{
+			name:   "active primary",
+			config: map[string]interface{}{"active": true, "backupmx": false},
+			expected: map[string]string{
+				"domains.#":        "1",
+				"domains.0.domain": "440044.xyz",
+			},
+		},
+		{
+			name:   "inactive",
+			config: map[string]interface{}{"active": false},
+			expected: map[string]string{
+				"domains.#":        "1",
+				"domains.0.domain": "660066.xyz",
+				"domains.0.active": "false",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := testMockReadData(t, dataSourceDomains(), tc.config, meta)
+			testMockCheckAttrs(t, state, tc.expected)
+		})
+	}
+}
@@ -88,11 +88,53 @@ func dataSourceMailbox() *schema.Resource {
 				Description: "if 'Sieve' is an allowed protocol",
 				Computed:    true,
 			},
-			//"relayhost": "0",
-			//"passwd_update": "2022-07-15 20:31:51",
-			//"mailbox_format": "maildir:",
-			//"quarantine_notification": "hourly",
-			//"quarantine_category": "reject"
+			"relayhost": {
+				Type:        schema.TypeString,
+				Description: "id of the sender-dependent relayhost, \"0\" for none",
+				Computed:    true,
+			},
+			"eas_access": {
+				Type:        schema.TypeBool,
+				Description: "if 'ActiveSync' is an allowed protocol",
+				Computed:    true,
+			},
+			"dav_access": {
+				Type:        schema.TypeBool,
+				Description: "if 'CalDAV' and 'CardDAV' are allowed protocols",
+				Computed:    true,
+			},
+			"quarantine_notification": {
+				Type:        schema.TypeString,
+				Description: "frequency of quarantine notifications",
+				Computed:    true,
+			},
+			"quarantine_category": {
+				Type:        schema.TypeString,
+				Description: "category of quarantined mails notified about",
+				Computed:    true,
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Description: "tags of the mailbox",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_attributes": {
+				Type:        schema.TypeMap,
+				Description: "custom attributes of the mailbox",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"passwd_update": {
+				Type:        schema.TypeString,
+				Description: "time of the last password update",
+				Computed:    true,
+			},
+			"mailbox_format": {
+				Type:        schema.TypeString,
+				Description: "mailbox format of dovecot",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -113,21 +155,12 @@ func dataSourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(errors.New(fmt.Sprint("mailbox '", id, "' not found")))
 	}
 
-	exclude := []string{"password"}
+	// a fresh slice, appending to the package-level list could corrupt concurrent reads
+	exclude := append([]string{}, mailboxPasswordArguments...)
 	mailbox["quota"] = int(mailbox["quota"].(float64)) / (1024 * 1024)
-	mailboxAttributes := []string{
-		"force_pw_update",
-		"tls_enforce_in",
-		"tls_enforce_out",
-		"sogo_access",
-		"imap_access",
-		"pop3_access",
-		"smtp_access",
-		"sieve_access",
-	}
 	mailbox["address"] = id
 	mailbox["full_name"] = mailbox["name"]
-	excludeAndAttributes := append(exclude, mailboxAttributes...)
+	excludeAndAttributes := append(append(append([]string{}, exclude...), mailboxAttributes...), mailboxCollections...)
 	err = setResourceData(resourceMailbox(), d, &mailbox, &excludeAndAttributes, nil)
 	if err != nil {
 		return diag.FromErr(err)
@@ -137,6 +170,10 @@ func dataSourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = setMailboxCollections(d, mailbox)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(id)
 
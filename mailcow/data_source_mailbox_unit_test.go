@@ -0,0 +1,48 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestDataSourceMailboxMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+
+	testMockApply(t, resourceMailbox(), nil, map[string]interface{}{
+		"domain":                  "440044.xyz",
+		"local_part":              "demo",
+		"full_name":               "Demo User",
+		"password":                "secret-password",
+		"quota":                   2048,
+		"dav_access":              false,
+		"quarantine_notification": "daily",
+		"quarantine_category":     "all",
+		"tags":                    []interface{}{"staff", "sales"},
+		"custom_attributes":       map[string]interface{}{"department": "sales"},
+	}, meta)
+
+	state := testMockReadData(t, dataSourceMailbox(), map[string]interface{}{
+		"address": "demo@440044.xyz",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"address":                      "demo@440044.xyz",
+		"full_name":                    "Demo User",
+		"quota":                        "2048",
+		"eas_access":                   "true",
+		"dav_access":                   "false",
+		"quarantine_notification":      "daily",
+		"quarantine_category":          "all",
+		"mailbox_format":               "maildir:",
+		"tags.#":                       "2",
+		"custom_attributes.%":          "1",
+		"custom_attributes.department": "sales",
+	})
+	if state.Attributes["passwd_update"] == "" {
+		t.Error("passwd_update not set")
+	}
+	for _, argument := range append([]string{"mock_password", "password2"}, mailboxPasswordArguments...) {
+		if _, ok := state.Attributes[argument]; ok {
+			t.Errorf("password argument %s in the state of the data source", argument)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package mailcow
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMailboxes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMailboxesRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "only list the mailboxes of this domain",
+				Optional:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "only list active (true) or inactive (false) mailboxes",
+				Optional:    true,
+			},
+			"authsource": {
+				Type:         schema.TypeString,
+				Description:  "only list the mailboxes with this authentication source. One of: generic-oidc, mailcow, keycloak, ldap.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{mailcowAuthsourceInternal, mailcowAuthsourceKeycloak, mailcowAuthsourceLdap, mailcowAuthsourceOidc}, false),
+			},
+			"local_part_regex": {
+				Type:         schema.TypeString,
+				Description:  "only list the mailboxes with a left part of the email address matching this regular expression",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"mailboxes": {
+				Type:        schema.TypeList,
+				Description: "the mailboxes matching the filters",
+				Computed:    true,
+				Elem:        dataSourceMailboxesElem(),
+			},
+		},
+	}
+}
+
+// dataSourceMailboxesElem has the attributes of the data source mailcow_mailbox
+func dataSourceMailboxesElem() *schema.Resource {
+	elem := dataSourceMailbox().Schema
+	elem["address"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "e-mail address",
+		Computed:    true,
+	}
+	return &schema.Resource{Schema: elem}
+}
+
+func dataSourceMailboxesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*APIClient)
+	domain := d.Get("domain").(string)
+
+	request := c.client.Api.MailcowGetMailboxes(ctx, domain)
+
+	mailboxes, err := readAllRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// GetOkExists distinguishes active = false from no filter
+	active, filterActive := d.GetOkExists("active")
+	authsource := d.Get("authsource").(string)
+	localPartRegex := regexp.MustCompile(d.Get("local_part_regex").(string))
+
+	elem := dataSourceMailboxesElem()
+	result := make([]interface{}, 0, len(mailboxes))
+	for _, mailbox := range mailboxes {
+		mailbox["address"] = mailboxAddress(mailbox)
+		mailbox["full_name"] = mailbox["name"]
+		if mailbox["quota"] != nil {
+			mailbox["quota"] = int(mailbox["quota"].(float64)) / (1024 * 1024)
+		} else {
+			mailbox["quota"] = 0
+		}
+		if attributes, ok := mailbox["attributes"].(map[string]interface{}); ok {
+			for _, attribute := range mailboxAttributes {
+				mailbox[attribute] = attributes[attribute]
+			}
+		}
+
+		flattened, err := flattenResourceData(elem, &mailbox, &mailboxCollections)
+		if err != nil {
+			return diag.Errorf("mailbox '%s': %s", mailbox["address"], err)
+		}
+		flattened["tags"] = mailboxTags(mailbox)
+		flattened["custom_attributes"] = mailboxCustomAttributes(mailbox)
+		if filterActive && flattened["active"] != active {
+			continue
+		}
+		if authsource != "" && flattened["authsource"] != authsource {
+			continue
+		}
+		if !localPartRegex.MatchString(flattened["local_part"].(string)) {
+			continue
+		}
+		result = append(result, flattened)
+	}
+
+	err = d.Set("mailboxes", result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if domain == "" {
+		d.SetId("all")
+	} else {
+		d.SetId(domain)
+	}
+
+	return diags
+}
@@ -0,0 +1,73 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceMailboxes(t *testing.T) {
+	domain := fmt.Sprintf("with-ds-mailboxes-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	localPart := fmt.Sprintf("with-ds-mailboxes-%s", randomLowerCaseString(4))
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMailboxes(domain, localPart),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.all", "mailboxes.#", "2"),
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.inactive", "mailboxes.#", "1"),
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.inactive", "mailboxes.0.address", "inactive-"+localPart+"@"+domain),
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.inactive", "mailboxes.0.active", "false"),
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.regex", "mailboxes.#", "1"),
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.regex", "mailboxes.0.local_part", localPart),
+					resource.TestCheckResourceAttr("data.mailcow_mailboxes.regex", "mailboxes.0.quota", "1024"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMailboxes(domain string, localPart string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain   = "%[1]s"
+  quota    = 20480
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  local_part = "%[2]s"
+  domain     = mailcow_domain.domain.id
+  password   = "secret-password"
+  full_name  = "active mailbox"
+  quota      = 1024
+}
+
+resource "mailcow_mailbox" "inactive" {
+  local_part = "inactive-%[2]s"
+  domain     = mailcow_domain.domain.id
+  password   = "secret-password"
+  full_name  = "inactive mailbox"
+  active     = false
+}
+
+data "mailcow_mailboxes" "all" {
+  domain     = mailcow_domain.domain.id
+  depends_on = [mailcow_mailbox.mailbox, mailcow_mailbox.inactive]
+}
+
+data "mailcow_mailboxes" "inactive" {
+  domain     = mailcow_domain.domain.id
+  active     = false
+  depends_on = [mailcow_mailbox.mailbox, mailcow_mailbox.inactive]
+}
+
+data "mailcow_mailboxes" "regex" {
+  domain           = mailcow_domain.domain.id
+  local_part_regex = "^with-ds"
+  depends_on       = [mailcow_mailbox.mailbox, mailcow_mailbox.inactive]
+}
+`, domain, localPart)
+}
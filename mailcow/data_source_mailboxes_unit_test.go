@@ -0,0 +1,85 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestDataSourceMailboxesMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+
+	for _, mailbox := range []map[string]interface{}{
+		{"domain": "440044.xyz", "local_part": "demo", "full_name": "Demo User", "password": "secret-password", "quota": 2048},
+		{"domain": "440044.xyz", "local_part": "info", "full_name": "Info", "password": "secret-password", "active": false},
+		{"domain": "550055.xyz", "local_part": "demo", "full_name": "Other Demo", "password": "secret-password", "authsource": "keycloak"},
+	} {
+		testMockApply(t, resourceMailbox(), nil, mailbox, meta)
+	}
+
+	testCases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			name:   "all",
+			config: map[string]interface{}{},
+			expected: map[string]string{
+				"id":                      "all",
+				"mailboxes.#":             "3",
+				"mailboxes.0.address":     "demo@440044.xyz",
+				"mailboxes.0.full_name":   "Demo User",
+				"mailboxes.0.quota":       "2048",
+				"mailboxes.0.active":      "true",
+				"mailboxes.0.imap_access": "true",
+			},
+		},
+		{
+			name:   "domain",
+			config: map[string]interface{}{"domain": "550055.xyz"},
+			expected: map[string]string{
+				"id":                     "550055.xyz",
+				"mailboxes.#":            "1",
+				"mailboxes.0.address":    "demo@550055.xyz",
+				"mailboxes.0.authsource": "keycloak",
+			},
+		},
+		{
+			name:   "inactive",
+			config: map[string]interface{}{"active": false},
+			expected: map[string]string{
+				"mailboxes.#":         "1",
+				"mailboxes.0.address": "info@440044.xyz",
+				"mailboxes.0.active":  "false",
+			},
+		},
+		{
+			name:   "active",
+			config: map[string]interface{}{"active": true},
+			expected: map[string]string{
+				"mailboxes.#": "2",
+			},
+		},
+		{
+			name:   "authsource",
+			config: map[string]interface{}{"authsource": "mailcow"},
+			expected: map[string]string{
+				"mailboxes.#": "2",
+			},
+		},
+		{
+			name:   "local part regex",
+			config: map[string]interface{}{"domain": "440044.xyz", "local_part_regex": "^in"},
+			expected: map[string]string{
+				"mailboxes.#":            "1",
+				"mailboxes.0.local_part": "info",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := testMockReadData(t, dataSourceMailboxes(), tc.config, meta)
+			testMockCheckAttrs(t, state, tc.expected)
+		})
+	}
+}
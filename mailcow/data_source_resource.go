@@ -0,0 +1,69 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "e-mail address of the resource",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "description of the resource",
+				Computed:    true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "domain of the resource",
+				Computed:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "kind of the resource: location, group or thing",
+				Computed:    true,
+			},
+			"multiple_bookings": {
+				Type:        schema.TypeInt,
+				Description: "number of bookings at the same time, 0 for one booking only, -1 for unlimited",
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is resource active or not",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceResourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*APIClient)
+	id := d.Get("name").(string)
+
+	resource, err := getResource(ctx, c.client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resource == nil {
+		return diag.Errorf("resource '%s' not found", id)
+	}
+
+	err = setResourceData(dataSourceResource(), d, &resource, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
@@ -0,0 +1,62 @@
+package mailcow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceResource(t *testing.T) {
+	domain := fmt.Sprintf("with-ds-resource-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceResource(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailcow_resource.resource", "name", "Projector@"+domain),
+					resource.TestCheckResourceAttr("data.mailcow_resource.resource", "description", "Projector"),
+					resource.TestCheckResourceAttr("data.mailcow_resource.resource", "domain", domain),
+					resource.TestCheckResourceAttr("data.mailcow_resource.resource", "kind", "thing"),
+					resource.TestCheckResourceAttr("data.mailcow_resource.resource", "multiple_bookings", "2"),
+					resource.TestCheckResourceAttr("data.mailcow_resource.resource", "active", "true"),
+				),
+			},
+			{
+				Config:      testAccDataSourceResourceError(),
+				ExpectError: regexp.MustCompile("not found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceResource(domain string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_resource" "resource" {
+  description       = "Projector"
+  domain            = mailcow_domain.domain.domain
+  kind              = "thing"
+  multiple_bookings = 2
+}
+
+data "mailcow_resource" "resource" {
+  name = mailcow_resource.resource.name
+}
+`, domain)
+}
+
+func testAccDataSourceResourceError() string {
+	return `
+data "mailcow_resource" "resource" {
+  name = "does-not-exist@440044.xyz"
+}
+`
+}
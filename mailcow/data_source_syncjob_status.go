@@ -0,0 +1,129 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSyncjobStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSyncjobStatusRead,
+		Schema: map[string]*schema.Schema{
+			"syncjob_id": {
+				Type:        schema.TypeString,
+				Description: "id of the sync job",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "user to login on local host (--user2), if set the sync job is read with a single request for the sync jobs of the mailbox instead of listing all sync jobs first",
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is sync job active or not",
+				Computed:    true,
+			},
+			"last_run": {
+				Type:        schema.TypeString,
+				Description: "time of the last run, empty if the sync job did not run yet",
+				Computed:    true,
+			},
+			"success": {
+				Type:        schema.TypeBool,
+				Description: "if the last run was successful",
+				Computed:    true,
+			},
+			"exit_status": {
+				Type:        schema.TypeString,
+				Description: "exit status of imapsync of the last run, e.g. \"EXIT_OK\"",
+				Computed:    true,
+			},
+			"is_running": {
+				Type:        schema.TypeBool,
+				Description: "if the sync job is running",
+				Computed:    true,
+			},
+			"log": {
+				Type:        schema.TypeString,
+				Description: "imapsync log of the last run",
+				Computed:    true,
+			},
+			"messages_transferred": {
+				Type:        schema.TypeInt,
+				Description: "number of messages transferred by the last run",
+				Computed:    true,
+			},
+			"messages_skipped": {
+				Type:        schema.TypeInt,
+				Description: "number of messages skipped by the last run, e.g. because they were already transferred",
+				Computed:    true,
+			},
+			"bytes_transferred": {
+				Type:        schema.TypeInt,
+				Description: "number of bytes transferred by the last run",
+				Computed:    true,
+			},
+			"errors": {
+				Type:        schema.TypeInt,
+				Description: "number of errors imapsync detected in the last run",
+				Computed:    true,
+			},
+			"duration": {
+				Type:        schema.TypeFloat,
+				Description: "duration of the transfer of the last run in seconds",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSyncjobStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*APIClient)
+	id := d.Get("syncjob_id").(string)
+
+	var syncJob map[string]interface{}
+	var err error
+	if username := d.Get("username").(string); username != "" {
+		syncJob, err = getSyncJob(ctx, c, username, "id", id)
+	} else {
+		syncJob, err = getSyncJobWithLog(ctx, c, id)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if syncJob == nil {
+		return diag.Errorf("syncjob '%s' not found", id)
+	}
+
+	err = setSyncJobStatus(d, syncJob)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	summary := parseImapsyncSummary(syncJobLog(syncJob))
+	for argument, value := range map[string]interface{}{
+		"username":             fmt.Sprint(syncJob["user2"]),
+		"active":               fmt.Sprint(syncJob["active"]) == "1",
+		"log":                  syncJobLog(syncJob),
+		"messages_transferred": summary.messagesTransferred,
+		"messages_skipped":     summary.messagesSkipped,
+		"bytes_transferred":    summary.bytesTransferred,
+		"errors":               summary.errors,
+		"duration":             summary.duration,
+	} {
+		err = d.Set(argument, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(id)
+
+	return diags
+}
@@ -0,0 +1,50 @@
+package mailcow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSyncjobStatus(t *testing.T) {
+	domain := fmt.Sprintf("with-ds-syncjob-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	localPart := fmt.Sprintf("with-ds-syncjob-%s", randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSyncjobStatus(domain, localPart),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailcow_syncjob_status.syncjob", "username", localPart+"@"+domain),
+					resource.TestCheckResourceAttr("data.mailcow_syncjob_status.syncjob", "active", "true"),
+					resource.TestCheckResourceAttrSet("data.mailcow_syncjob_status.syncjob", "messages_transferred"),
+					resource.TestCheckResourceAttrSet("data.mailcow_syncjob_status.syncjob", "errors"),
+				),
+			},
+			{
+				Config:      testAccDataSourceSyncjobStatusError(),
+				ExpectError: regexp.MustCompile("not found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSyncjobStatus(domain string, localPart string) string {
+	return testAccResourceSyncjob(domain, localPart, "example.com", "demo@example.com") + `
+data "mailcow_syncjob_status" "syncjob" {
+  syncjob_id = mailcow_syncjob.syncjob.id
+}
+`
+}
+
+func testAccDataSourceSyncjobStatusError() string {
+	return `
+data "mailcow_syncjob_status" "syncjob" {
+  syncjob_id = "999999"
+}
+`
+}
@@ -0,0 +1,85 @@
+package mailcow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDataSourceSyncjobStatusMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+
+	syncjob := testMockApply(t, resourceSyncjob(), nil, map[string]interface{}{
+		"username":  "demo@440044.xyz",
+		"host1":     "imap.example.com",
+		"user1":     "demo@example.com",
+		"password1": "secret-password",
+	}, meta)
+
+	state := testMockReadData(t, dataSourceSyncjobStatus(), map[string]interface{}{"syncjob_id": syncjob.ID}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"username":             "demo@440044.xyz",
+		"active":               "true",
+		"last_run":             "",
+		"success":              "false",
+		"is_running":           "false",
+		"messages_transferred": "0",
+		"duration":             "0",
+	})
+
+	// mailcow updates the status when imapsync runs the sync job
+	object := mock.get("syncjob", syncjob.ID)
+	object["last_run"] = "2026-10-18 10:00:42"
+	object["success"] = 1
+	object["exit_status"] = "EXIT_OK"
+	object["is_running"] = 0
+	object["log"] = mockImapsyncLog
+
+	state = testMockReadData(t, dataSourceSyncjobStatus(), map[string]interface{}{"syncjob_id": syncjob.ID}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":                   syncjob.ID,
+		"last_run":             "2026-10-18 10:00:42",
+		"success":              "true",
+		"exit_status":          "EXIT_OK",
+		"is_running":           "false",
+		"log":                  mockImapsyncLog,
+		"messages_transferred": "120",
+		"messages_skipped":     "3000",
+		"bytes_transferred":    "12345678",
+		"errors":               "2",
+		"duration":             "42.3",
+	})
+
+	// with the username a single request for the sync jobs of the mailbox is sent
+	before := mock.requestCount()
+	state = testMockReadData(t, dataSourceSyncjobStatus(), map[string]interface{}{"syncjob_id": syncjob.ID, "username": "demo@440044.xyz"}, meta)
+	if requests := mock.requestCount() - before; requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	testMockCheckAttrs(t, state, map[string]string{
+		"username":             "demo@440044.xyz",
+		"log":                  mockImapsyncLog,
+		"messages_transferred": "120",
+	})
+
+	res := dataSourceSyncjobStatus()
+	diff, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"syncjob_id": "42"}), meta)
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	_, diags := res.ReadDataApply(context.Background(), diff, meta)
+	if !diags.HasError() {
+		t.Error("expected an error reading the status of an unknown syncjob")
+	}
+
+	diff, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"syncjob_id": syncjob.ID, "username": "other@440044.xyz"}), meta)
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	_, diags = res.ReadDataApply(context.Background(), diff, meta)
+	if !diags.HasError() {
+		t.Error("expected an error reading the status of a syncjob of another mailbox")
+	}
+}
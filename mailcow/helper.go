@@ -1,18 +1,31 @@
 package mailcow
 
 import (
+	cryptorand "crypto/rand"
 	"fmt"
 	"log"
+	"math/big"
 	"math/rand"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceDataSet(rd *schema.ResourceData, argument string, value any, elem *schema.Schema) error {
+	log.Printf("[TRACE] resourceDataSet %s expected type %s, value %s", argument, elem.Type, logValue(elem, fmt.Sprint(value)))
+	setValue, err := resourceDataValue(value, elem)
+	if err != nil {
+		return err
+	}
+	log.Printf("[TRACE] resourceDataSet %s setVvalue %s", argument, logValue(elem, setValue))
+	return rd.Set(argument, setValue)
+}
+
+// resourceDataValue converts a value returned by mailcow to the type of the schema
+func resourceDataValue(value any, elem *schema.Schema) (any, error) {
 	stringValue := fmt.Sprint(value)
-	log.Printf("[TRACE] resourceDataSet %s expected type %s, value %s", argument, elem.Type, stringValue)
 	setValue := value
 	var err error
 	switch elem.Type {
@@ -28,7 +41,7 @@ func resourceDataSet(rd *schema.ResourceData, argument string, value any, elem *
 		var setValueInt int
 		setValueInt, err = strconv.Atoi(stringValue)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		setValue = setValueInt
 	case schema.TypeList:
@@ -48,8 +61,15 @@ func resourceDataSet(rd *schema.ResourceData, argument string, value any, elem *
 	default:
 		setValue = stringValue
 	}
-	log.Printf("[TRACE] resourceDataSet %s setVvalue %s", argument, setValue)
-	return rd.Set(argument, setValue)
+	return setValue, nil
+}
+
+// logValue masks the value of a sensitive attribute in log messages
+func logValue(elem *schema.Schema, value any) any {
+	if elem.Sensitive {
+		return "***"
+	}
+	return value
 }
 
 func setResourceData(res *schema.Resource, data *schema.ResourceData, resource *map[string]interface{}, exclude *[]string, only *[]string) error {
@@ -69,6 +89,22 @@ func setResourceData(res *schema.Resource, data *schema.ResourceData, resource *
 	return nil
 }
 
+// flattenResourceData converts an object returned by mailcow to an element of a list of objects of the schema
+func flattenResourceData(res *schema.Resource, resource *map[string]interface{}, exclude *[]string) (map[string]interface{}, error) {
+	flattened := make(map[string]interface{})
+	for argument, elem := range (*res).Schema {
+		if isElementIn(argument, exclude) {
+			continue
+		}
+		value, err := resourceDataValue((*resource)[argument], elem)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", argument, err)
+		}
+		flattened[argument] = value
+	}
+	return flattened, nil
+}
+
 func getMappedArgument(argument string, mapArguments *map[string]string) string {
 	if mapArguments == nil {
 		return argument
@@ -101,3 +137,69 @@ func randomLowerCaseString(length int) string {
 	}
 	return string(b)
 }
+
+// the character classes of generated passwords
+const (
+	passwordCharsetLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordCharsetUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordCharsetNumeric = "0123456789"
+	passwordCharsetSpecial = "!#$%&*+-.:=?@_"
+)
+
+// randomPassword returns a password of letters and digits from a cryptographically secure source
+func randomPassword(length int) (string, error) {
+	return generatePassword(length, []string{passwordCharsetLower, passwordCharsetUpper, passwordCharsetNumeric})
+}
+
+// generatePassword returns a password with at least one character of each charset from a cryptographically secure source
+func generatePassword(length int, charsets []string) (string, error) {
+	if len(charsets) == 0 || length < len(charsets) {
+		return "", fmt.Errorf("cannot generate a password of length %d with %d character classes", length, len(charsets))
+	}
+	all := strings.Join(charsets, "")
+	b := make([]byte, length)
+	for i := range b {
+		charset := all
+		if i < len(charsets) {
+			charset = charsets[i]
+		}
+		n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = charset[n.Int64()]
+	}
+	// shuffle, so that the guaranteed characters are not at the beginning
+	for i := len(b) - 1; i > 0; i-- {
+		n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		j := n.Int64()
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b), nil
+}
+
+// newestId returns the highest numeric id of the objects matching, mailcow does not return the id of some added objects
+func newestId(objects []map[string]interface{}, match func(object map[string]interface{}) bool) (string, bool) {
+	newest := -1
+	for _, object := range objects {
+		id, err := strconv.Atoi(fmt.Sprint(object["id"]))
+		if err == nil && id > newest && match(object) {
+			newest = id
+		}
+	}
+	if newest < 0 {
+		return "", false
+	}
+	return strconv.Itoa(newest), true
+}
+
+func setToStringList(set *schema.Set) []string {
+	list := make([]string, 0, set.Len())
+	for _, item := range set.List() {
+		list = append(list, item.(string))
+	}
+	return list
+}
@@ -0,0 +1,60 @@
+package mailcow
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// imapsyncSummary is the summary imapsync logs at the end of a run
+type imapsyncSummary struct {
+	messagesTransferred int
+	messagesSkipped     int
+	bytesTransferred    int
+	errors              int
+	// duration of the transfer in seconds
+	duration float64
+}
+
+var (
+	imapsyncMessagesTransferredPattern = regexp.MustCompile(`(?m)^Messages transferred\s*:\s*([0-9]+)`)
+	imapsyncMessagesSkippedPattern     = regexp.MustCompile(`(?m)^Messages skipped\s*:\s*([0-9]+)`)
+	imapsyncBytesTransferredPattern    = regexp.MustCompile(`(?m)^Total bytes transferred\s*:\s*([0-9]+)`)
+	imapsyncDurationPattern            = regexp.MustCompile(`(?m)^Transfer time\s*:\s*([0-9]+(?:\.[0-9]+)?) sec`)
+	imapsyncErrorsPattern              = regexp.MustCompile(`(?m)^Detected ([0-9]+) errors`)
+)
+
+// parseImapsyncSummary parses the statistics of the last run from an imapsync log, values not found are 0
+func parseImapsyncSummary(log string) imapsyncSummary {
+	return imapsyncSummary{
+		messagesTransferred: imapsyncInt(imapsyncMessagesTransferredPattern, log),
+		messagesSkipped:     imapsyncInt(imapsyncMessagesSkippedPattern, log),
+		bytesTransferred:    imapsyncInt(imapsyncBytesTransferredPattern, log),
+		errors:              imapsyncInt(imapsyncErrorsPattern, log),
+		duration:            imapsyncFloat(imapsyncDurationPattern, log),
+	}
+}
+
+// imapsyncValue returns the value of the last match, a log can hold several runs
+func imapsyncValue(pattern *regexp.Regexp, log string) string {
+	matches := pattern.FindAllStringSubmatch(log, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[len(matches)-1][1]
+}
+
+func imapsyncInt(pattern *regexp.Regexp, log string) int {
+	value, err := strconv.Atoi(imapsyncValue(pattern, log))
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+func imapsyncFloat(pattern *regexp.Regexp, log string) float64 {
+	value, err := strconv.ParseFloat(imapsyncValue(pattern, log), 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
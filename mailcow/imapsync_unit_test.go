@@ -0,0 +1,45 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+// mockImapsyncLog is the end of an imapsync log of a run
+const mockImapsyncLog = `Host1: IMAP server [imap.example.com] port [993] user [demo@example.com]
+Host2: IMAP server [dovecot] port [143] user [demo@440044.xyz]
+++++ Statistics
+Transfer started on                     : Sun Oct 18 10:00:00 2026
+Transfer ended on                       : Sun Oct 18 10:00:42 2026
+Transfer time                           : 42.3 sec
+Folders synced                          : 12/12 synced
+Messages transferred                    : 120
+Messages skipped                        : 3000
+Messages found duplicate on host1       : 0
+Total bytes transferred                 : 12345678 (11.774 MiB)
+Detected 2 errors
+`
+
+func TestParseImapsyncSummary(t *testing.T) {
+	summary := parseImapsyncSummary(mockImapsyncLog)
+	expected := imapsyncSummary{
+		messagesTransferred: 120,
+		messagesSkipped:     3000,
+		bytesTransferred:    12345678,
+		errors:              2,
+		duration:            42.3,
+	}
+	if summary != expected {
+		t.Errorf("expected %+v, got %+v", expected, summary)
+	}
+
+	// the log of the running sync job has no summary yet
+	if summary := parseImapsyncSummary("Host1: IMAP server [imap.example.com]\n"); summary != (imapsyncSummary{}) {
+		t.Errorf("expected an empty summary, got %+v", summary)
+	}
+
+	// the summary of the last run counts
+	summary = parseImapsyncSummary(mockImapsyncLog + "Messages transferred : 7\nDetected 0 errors\n")
+	if summary.messagesTransferred != 7 || summary.errors != 0 {
+		t.Errorf("expected the summary of the last run, got %+v", summary)
+	}
+}
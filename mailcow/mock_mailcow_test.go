@@ -0,0 +1,1032 @@
+package mailcow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const mockMailcowApiKey = "mock-api-key"
+
+// mockMailcowKind describes how the fake mailcow API handles one object type.
+type mockMailcowKind struct {
+	// payload key of the add request holding the id, empty for numeric ids
+	idKey string
+	// computes the id from the add request instead of idKey
+	idFunc func(payload map[string]interface{}) string
+	// payload key echoed in the add response before numeric ids
+	msgKey string
+	// get endpoint name, defaults to the kind name
+	getName string
+	// objects are listed by this key on get instead of being returned by id
+	listKey string
+	// listKey only lists the objects with these values (e.g. the policy list)
+	listMatch map[string]string
+	// get all/{value} lists the objects with this key matching the value (e.g. the domain)
+	allKey string
+	// there is only one object of this kind, get has no id
+	singleton bool
+	// edit creates unknown objects instead of denying access (e.g. rate limits)
+	upsert bool
+	// the object is stored under another kind (e.g. da-acl edits domain-admin)
+	storeKind string
+	// delete removes the items from the object named by the path instead of deleting objects (e.g. mailbox tags)
+	removeItems func(object map[string]interface{}, items []string)
+	// msg[0] of the add response
+	addMsg string
+	// apply converts add or edit attributes into the object returned by get
+	apply func(object map[string]interface{}, attr map[string]interface{})
+}
+
+// mockMailcow is an in-memory fake of the mailcow API v1.
+type mockMailcow struct {
+	t      *testing.T
+	server *httptest.Server
+	kinds  map[string]*mockMailcowKind
+
+	mu       sync.Mutex
+	nextId   int
+	objects  map[string]map[string]map[string]interface{}
+	order    map[string][]string
+	failures []mockMailcowFailure
+	requests int
+	// paths are the paths of the requests received, without the /api/v1/ prefix
+	paths []string
+
+	// delay slows every request down to make concurrent requests overlap
+	delay       time.Duration
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+// mockMailcowFailure is answered instead of the next request.
+type mockMailcowFailure struct {
+	statusCode int
+	retryAfter string
+}
+
+func newMockMailcow(t *testing.T) *mockMailcow {
+	mock := &mockMailcow{
+		t:       t,
+		kinds:   mockMailcowKinds(),
+		nextId:  1,
+		objects: make(map[string]map[string]map[string]interface{}),
+		order:   make(map[string][]string),
+	}
+	mock.server = httptest.NewTLSServer(http.HandlerFunc(mock.serveHTTP))
+	t.Cleanup(mock.server.Close)
+	return mock
+}
+
+// meta configures the provider against the fake API and returns its meta.
+func (mock *mockMailcow) meta() interface{} {
+	return mock.metaWith(nil)
+}
+
+// metaWith configures the provider with additional arguments against the fake API and returns its meta.
+func (mock *mockMailcow) metaWith(arguments map[string]interface{}) interface{} {
+	provider := Provider()
+	serverUrl, _ := url.Parse(mock.server.URL)
+	raw := map[string]interface{}{
+		"host_name":      serverUrl.Host,
+		"api_key":        mockMailcowApiKey,
+		"insecure":       true,
+		"retry_wait_min": "1ms",
+		"retry_wait_max": "10ms",
+	}
+	for key, value := range arguments {
+		raw[key] = value
+	}
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		mock.t.Fatalf("configure provider: %v", diags)
+	}
+	return provider.Meta()
+}
+
+// fail answers the next requests with the given failures.
+func (mock *mockMailcow) fail(failures ...mockMailcowFailure) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.failures = append(mock.failures, failures...)
+}
+
+// requestCount returns the number of requests received.
+func (mock *mockMailcow) requestCount() int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.requests
+}
+
+// put stores an object directly, bypassing the API.
+func (mock *mockMailcow) put(kind string, id string, object map[string]interface{}) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.store(kind, id, object)
+}
+
+// get returns a stored object, nil if it does not exist.
+func (mock *mockMailcow) get(kind string, id string) map[string]interface{} {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.objects[kind][id]
+}
+
+// all returns the stored objects of the kind in the order of creation.
+func (mock *mockMailcow) all(kind string) []map[string]interface{} {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.list(kind)
+}
+
+// remove deletes an object directly, bypassing the API.
+func (mock *mockMailcow) remove(kind string, id string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.unstore(kind, id)
+}
+
+func (mock *mockMailcow) store(kind string, id string, object map[string]interface{}) {
+	if mock.objects[kind] == nil {
+		mock.objects[kind] = make(map[string]map[string]interface{})
+	}
+	if _, ok := mock.objects[kind][id]; !ok {
+		mock.order[kind] = append(mock.order[kind], id)
+	}
+	mock.objects[kind][id] = object
+}
+
+func (mock *mockMailcow) unstore(kind string, id string) bool {
+	if _, ok := mock.objects[kind][id]; !ok {
+		return false
+	}
+	delete(mock.objects[kind], id)
+	for i, orderId := range mock.order[kind] {
+		if orderId == id {
+			mock.order[kind] = append(mock.order[kind][:i], mock.order[kind][i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (mock *mockMailcow) list(kind string) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0)
+	for _, id := range mock.order[kind] {
+		list = append(list, mock.objects[kind][id])
+	}
+	return list
+}
+
+// requested returns the number of requests received with the path.
+func (mock *mockMailcow) requested(path string) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	count := 0
+	for _, requestedPath := range mock.paths {
+		if requestedPath == path {
+			count++
+		}
+	}
+	return count
+}
+
+func (mock *mockMailcow) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	inFlight := mock.inFlight.Add(1)
+	defer mock.inFlight.Add(-1)
+	for {
+		maxInFlight := mock.maxInFlight.Load()
+		if inFlight <= maxInFlight || mock.maxInFlight.CompareAndSwap(maxInFlight, inFlight) {
+			break
+		}
+	}
+	time.Sleep(mock.delay)
+
+	if r.Header.Get("X-API-Key") != mockMailcowApiKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"type": "error", "msg": "authentication failed"})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v1/")
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		parts[i], _ = url.PathUnescape(part)
+	}
+	if len(parts) < 2 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.requests++
+	mock.paths = append(mock.paths, path)
+	if len(mock.failures) > 0 {
+		failure := mock.failures[0]
+		mock.failures = mock.failures[1:]
+		if failure.retryAfter != "" {
+			w.Header().Set("Retry-After", failure.retryAfter)
+		}
+		w.WriteHeader(failure.statusCode)
+		return
+	}
+
+	// sub-resources like mailbox/tag are kinds of their own
+	name, args := parts[1], parts[2:]
+	if len(args) > 0 && parts[0] != "get" {
+		if _, ok := mock.kinds[name+"/"+args[0]]; ok {
+			name, args = name+"/"+args[0], args[1:]
+		}
+	}
+
+	switch parts[0] {
+	case "add":
+		mock.serveAdd(w, r, name)
+	case "edit":
+		mock.serveEdit(w, r, name)
+	case "delete":
+		mock.serveDelete(w, r, name, args)
+	case "get":
+		mock.serveGet(w, parts[1], parts[2:])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (mock *mockMailcow) kind(name string) (string, *mockMailcowKind) {
+	kind, ok := mock.kinds[name]
+	if !ok {
+		return "", nil
+	}
+	if kind.storeKind != "" {
+		return kind.storeKind, kind
+	}
+	return name, kind
+}
+
+func (mock *mockMailcow) serveAdd(w http.ResponseWriter, r *http.Request, name string) {
+	storeKind, kind := mock.kind(name)
+	if kind == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	payload := make(map[string]interface{})
+	err := json.NewDecoder(r.Body).Decode(&payload)
+	if err != nil {
+		writeMailcowResponse(w, "danger", name, []interface{}{"malformed_request", err.Error()})
+		return
+	}
+
+	var id string
+	msg := []interface{}{kind.addMsg}
+	if kind.idKey == "" && kind.idFunc == nil {
+		id = fmt.Sprint(mock.nextId)
+		mock.nextId++
+		if kind.msgKey != "" {
+			msg = append(msg, fmt.Sprint(payload[kind.msgKey]))
+		}
+	} else {
+		if kind.idFunc != nil {
+			id = kind.idFunc(payload)
+		} else {
+			id = fmt.Sprint(payload[kind.idKey])
+		}
+		if id == "" || id == "<nil>" {
+			writeMailcowResponse(w, "danger", name, []interface{}{name + "_invalid"})
+			return
+		}
+		if _, ok := mock.objects[storeKind][id]; ok {
+			writeMailcowResponse(w, "danger", name, []interface{}{"object_exists", id})
+			return
+		}
+	}
+	msg = append(msg, id)
+
+	object := map[string]interface{}{"id": mockId(id)}
+	kind.apply(object, payload)
+	mock.store(storeKind, id, object)
+
+	writeMailcowResponse(w, "success", name, msg)
+}
+
+func (mock *mockMailcow) serveEdit(w http.ResponseWriter, r *http.Request, name string) {
+	storeKind, kind := mock.kind(name)
+	if kind == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	request := struct {
+		Items []string               `json:"items"`
+		Attr  map[string]interface{} `json:"attr"`
+	}{}
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		writeMailcowResponse(w, "danger", name, []interface{}{"malformed_request", err.Error()})
+		return
+	}
+
+	if kind.singleton {
+		object := mock.objects[storeKind][name]
+		if object == nil {
+			object = make(map[string]interface{})
+		}
+		kind.apply(object, request.Attr)
+		mock.store(storeKind, name, object)
+		writeMailcowResponse(w, "success", name, []interface{}{"object_modified", name})
+		return
+	}
+
+	for _, item := range request.Items {
+		object, ok := mock.objects[storeKind][item]
+		if !ok && kind.upsert {
+			object = make(map[string]interface{})
+			mock.store(storeKind, item, object)
+		} else if !ok {
+			writeMailcowResponse(w, "danger", name, []interface{}{"access_denied", item})
+			return
+		}
+		kind.apply(object, request.Attr)
+	}
+	writeMailcowResponse(w, "success", name, []interface{}{"object_modified", strings.Join(request.Items, ", ")})
+}
+
+func (mock *mockMailcow) serveDelete(w http.ResponseWriter, r *http.Request, name string, args []string) {
+	storeKind, kind := mock.kind(name)
+	if kind == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var body interface{}
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		writeMailcowResponse(w, "danger", name, []interface{}{"malformed_request", err.Error()})
+		return
+	}
+	items := make([]string, 0)
+	switch value := body.(type) {
+	case string:
+		items = append(items, value)
+	case []interface{}:
+		for _, item := range value {
+			items = append(items, fmt.Sprint(item))
+		}
+	}
+
+	if kind.removeItems != nil {
+		if len(args) == 0 || mock.objects[storeKind][args[0]] == nil {
+			writeMailcowResponse(w, "danger", name, []interface{}{"access_denied"})
+			return
+		}
+		kind.removeItems(mock.objects[storeKind][args[0]], items)
+		writeMailcowResponse(w, "success", name, []interface{}{"object_modified", args[0]})
+		return
+	}
+
+	for _, item := range items {
+		// like mailcow, deleting dkim keys of unknown domains is not an error
+		if !mock.unstore(storeKind, item) && name != "dkim" {
+			writeMailcowResponse(w, "danger", name, []interface{}{"access_denied", item})
+			return
+		}
+	}
+	writeMailcowResponse(w, "success", name, []interface{}{"object_removed", strings.Join(items, ", ")})
+}
+
+func (mock *mockMailcow) serveGet(w http.ResponseWriter, name string, args []string) {
+	var storeKind string
+	var kind *mockMailcowKind
+	for kindName, candidate := range mock.kinds {
+		getName := candidate.getName
+		if getName == "" {
+			getName = kindName
+		}
+		if getName == name {
+			storeKind, kind = mock.kind(kindName)
+			break
+		}
+	}
+	if kind == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if kind.singleton {
+		object := mock.objects[storeKind][storeKind]
+		if object == nil {
+			object = make(map[string]interface{})
+		}
+		writeJSON(w, object)
+		return
+	}
+
+	id := ""
+	if len(args) > 0 {
+		id = args[0]
+	}
+	if id == "all" {
+		list := mock.list(storeKind)
+		if len(args) > 1 && args[1] == "no_log" {
+			// like mailcow, all/no_log omits the logs of sync jobs
+			list = make([]map[string]interface{}, 0)
+			for _, object := range mock.list(storeKind) {
+				withoutLog := make(map[string]interface{})
+				for key, value := range object {
+					if key != "log" {
+						withoutLog[key] = value
+					}
+				}
+				list = append(list, withoutLog)
+			}
+		} else if len(args) > 1 && kind.allKey != "" {
+			list = make([]map[string]interface{}, 0)
+			for _, object := range mock.list(storeKind) {
+				if fmt.Sprint(object[kind.allKey]) == args[1] {
+					list = append(list, object)
+				}
+			}
+		}
+		writeJSON(w, list)
+		return
+	}
+	if kind.listKey != "" {
+		list := make([]map[string]interface{}, 0)
+		for _, object := range mock.list(storeKind) {
+			if fmt.Sprint(object[kind.listKey]) == id && mockMatch(object, kind.listMatch) {
+				list = append(list, object)
+			}
+		}
+		writeJSON(w, list)
+		return
+	}
+	object, ok := mock.objects[storeKind][id]
+	if !ok {
+		writeJSON(w, map[string]interface{}{})
+		return
+	}
+	writeJSON(w, object)
+}
+
+func mockId(id string) interface{} {
+	var number int
+	_, err := fmt.Sscan(id, &number)
+	if err == nil && fmt.Sprint(number) == id {
+		return number
+	}
+	return id
+}
+
+// mockMatch reports whether the object has all the values.
+func mockMatch(object map[string]interface{}, values map[string]string) bool {
+	for key, value := range values {
+		if fmt.Sprint(object[key]) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func writeMailcowResponse(w http.ResponseWriter, responseType string, name string, msg []interface{}) {
+	writeJSON(w, []map[string]interface{}{
+		{
+			"type": responseType,
+			"log":  []interface{}{name},
+			"msg":  msg,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// mockApplyAll copies all attributes into the object.
+func mockApplyAll(object map[string]interface{}, attr map[string]interface{}) {
+	for key, value := range attr {
+		object[key] = value
+	}
+}
+
+// mockApplyExcept returns an apply function skipping the given attributes.
+func mockApplyExcept(exclude ...string) func(map[string]interface{}, map[string]interface{}) {
+	return func(object map[string]interface{}, attr map[string]interface{}) {
+		for key, value := range attr {
+			if !isElementIn(key, &exclude) {
+				object[key] = value
+			}
+		}
+	}
+}
+
+// mockApplyPolicy returns an apply function converting an added spam policy entry of the owner (domain or username).
+func mockApplyPolicy(ownerKey string) func(map[string]interface{}, map[string]interface{}) {
+	return func(object map[string]interface{}, attr map[string]interface{}) {
+		object["prefid"] = object["id"]
+		object["object"] = attr[ownerKey]
+		object["object_list"] = attr["object_list"]
+		object["value"] = attr["object_from"]
+	}
+}
+
+// mockResourceName derives the e-mail address of a resource from its description like mailcow.
+func mockResourceName(attr map[string]interface{}) string {
+	localPart := regexp.MustCompile(`[^0-9a-zA-Z]`).ReplaceAllString(fmt.Sprint(attr["description"]), "")
+	return localPart + "@" + fmt.Sprint(attr["domain"])
+}
+
+// mockApplyRateLimit sets the rate limit like mailcow, an empty rl_value removes it.
+func mockApplyRateLimit(object map[string]interface{}, attr map[string]interface{}) {
+	if fmt.Sprint(attr["rl_value"]) == "" {
+		delete(object, "value")
+		delete(object, "frame")
+		return
+	}
+	object["value"] = fmt.Sprint(attr["rl_value"])
+	object["frame"] = attr["rl_frame"]
+}
+
+const mockMegaByte = 1024 * 1024
+
+// mockPasswordHash returns the hash of the password the mock keeps instead of the password
+func mockPasswordHash(password string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(password)))
+}
+
+// mockContains reports whether the list holds the value
+func mockContains(list []interface{}, value interface{}) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// mockApplyMailboxAttributes applies the attributes to the nested attributes of a mailbox
+func mockApplyMailboxAttributes(object map[string]interface{}, attr map[string]interface{}) {
+	attributes := object["attributes"].(map[string]interface{})
+	for key, value := range attr {
+		attributes[key] = value
+	}
+}
+
+func mockMailcowKinds() map[string]*mockMailcowKind {
+	return map[string]*mockMailcowKind{
+		"alias": {
+			addMsg: "alias_added",
+			msgKey: "address",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				mockApplyExcept("goto_ham", "goto_null", "goto_spam")(object, attr)
+				object["domain"] = object["address"].(string)[strings.Index(object["address"].(string), "@")+1:]
+				for flag, destination := range map[string]string{
+					"goto_ham":  gotoHamDestination,
+					"goto_null": gotoDiscardDestination,
+					"goto_spam": gotoSpamDestination,
+				} {
+					if fmt.Sprint(attr[flag]) == "1" {
+						object["goto"] = destination
+					}
+				}
+			},
+		},
+		"alias-domain": {
+			addMsg: "aliasd_added",
+			idKey:  "alias_domain",
+			apply:  mockApplyAll,
+		},
+		"domain": {
+			addMsg: "domain_added",
+			idKey:  "domain",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				if _, ok := object["domain_name"]; !ok {
+					object["bytes_total"] = 0
+					object["msgs_total"] = 0
+					object["mboxes_in_domain"] = 0
+					object["quota_used_in_domain"] = 0
+					object["domain_admins"] = ""
+					object["tags"] = []interface{}{}
+					object["rl"] = false
+					object["relayhost"] = relayhostNone
+				}
+				mapped := map[string]string{
+					"domain":    "domain_name",
+					"aliases":   "max_num_aliases_for_domain",
+					"mailboxes": "max_num_mboxes_for_domain",
+				}
+				quotas := map[string]string{
+					"defquota": "def_new_mailbox_quota",
+					"maxquota": "max_quota_for_mbox",
+					"quota":    "max_quota_for_domain",
+				}
+				for key, value := range attr {
+					if mappedKey, ok := mapped[key]; ok {
+						object[mappedKey] = value
+					} else if quotaKey, ok := quotas[key]; ok {
+						object[quotaKey] = value.(float64) * mockMegaByte
+					} else if key != "rl_value" && key != "rl_frame" {
+						object[key] = value
+					}
+				}
+				if attr["rl_value"] != nil && attr["rl_frame"] != nil {
+					object["rl"] = map[string]interface{}{
+						"value": fmt.Sprint(attr["rl_value"]),
+						"frame": attr["rl_frame"],
+					}
+				}
+				object["aliases_left"] = object["max_num_aliases_for_domain"]
+				object["mboxes_left"] = object["max_num_mboxes_for_domain"]
+			},
+		},
+		"mailbox": {
+			addMsg: "mailbox_added",
+			allKey: "domain",
+			idFunc: func(payload map[string]interface{}) string {
+				return fmt.Sprint(payload["local_part"], "@", payload["domain"])
+			},
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				attributes, ok := object["attributes"].(map[string]interface{})
+				if !ok {
+					attributes = map[string]interface{}{
+						"relayhost":               relayhostNone,
+						"passwd_update":           time.Now().Format(time.DateTime),
+						"mailbox_format":          "maildir:",
+						"quarantine_notification": "hourly",
+						"quarantine_category":     "reject",
+						"eas_access":              "1",
+						"dav_access":              "1",
+					}
+					object["attributes"] = attributes
+					object["tags"] = []interface{}{}
+					object["custom_attributes"] = []interface{}{}
+				}
+				for key, value := range attr {
+					switch {
+					case key == "password":
+						// mailcow never returns the password, the mock keeps its hash to check what was sent
+						object["mock_password"] = mockPasswordHash(fmt.Sprint(value))
+					case key == "password2" || key == "address":
+					case key == "quota":
+						object[key] = value.(float64) * mockMegaByte
+					case key == "tags":
+						// like mailcow, tags are only added
+						tags := object["tags"].([]interface{})
+						for _, tag := range value.([]interface{}) {
+							if !mockContains(tags, tag) {
+								tags = append(tags, tag)
+							}
+						}
+						object["tags"] = tags
+					case isElementIn(key, &mailboxAttributes):
+						attributes[key] = fmt.Sprint(value)
+					default:
+						object[key] = value
+					}
+				}
+				object["username"] = fmt.Sprint(object["local_part"], "@", object["domain"])
+			},
+		},
+		"mailbox/tag": {
+			storeKind: "mailbox",
+			removeItems: func(object map[string]interface{}, items []string) {
+				tags := make([]interface{}, 0)
+				for _, tag := range object["tags"].([]interface{}) {
+					if !isElementIn(fmt.Sprint(tag), &items) {
+						tags = append(tags, tag)
+					}
+				}
+				object["tags"] = tags
+			},
+		},
+		"mailbox/custom-attribute": {
+			storeKind: "mailbox",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				customAttributes := make(map[string]interface{})
+				values := attr["value"].([]interface{})
+				for i, attribute := range attr["attribute"].([]interface{}) {
+					customAttributes[fmt.Sprint(attribute)] = values[i]
+				}
+				object["custom_attributes"] = customAttributes
+			},
+		},
+		"quarantine_notification": {
+			storeKind: "mailbox",
+			apply:     mockApplyMailboxAttributes,
+		},
+		"quarantine_category": {
+			storeKind: "mailbox",
+			apply:     mockApplyMailboxAttributes,
+		},
+		"app-passwd": {
+			addMsg: "app_passwd_added",
+			allKey: "mailbox",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				for key, value := range attr {
+					switch key {
+					case "app_passwd", "app_passwd2":
+					case "username":
+						object["mailbox"] = value
+					case "app_name":
+						object["name"] = value
+					case "protocols":
+						for _, protocol := range appPasswordProtocols {
+							object[protocol+"_access"] = "0"
+						}
+						for _, access := range value.([]interface{}) {
+							object[access.(string)] = "1"
+						}
+					default:
+						object[key] = value
+					}
+				}
+			},
+		},
+		"bcc": {
+			addMsg: "bcc_saved",
+			apply:  mockApplyAll,
+		},
+		"dkim": {
+			addMsg: "dkim_added",
+			idKey:  "domains",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				selector := fmt.Sprint(attr["dkim_selector"])
+				object["dkim_selector"] = selector
+				object["length"] = attr["key_size"]
+				object["pubkey"] = "MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA"
+				object["dkim_txt"] = "v=DKIM1;k=rsa;t=s;s=email;p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA"
+			},
+		},
+		"relayhost": {
+			addMsg: "relayhost_added",
+			apply:  mockApplyAll,
+		},
+		"transport": {
+			addMsg: "transport_added",
+			apply:  mockApplyAll,
+		},
+		"tls-policy-map": {
+			addMsg: "tls_policy_map_entry_saved",
+			apply:  mockApplyAll,
+		},
+		"domain-policy": {
+			addMsg: "domain_modified",
+			apply:  mockApplyPolicy("domain"),
+		},
+		"policy_wl_domain": {
+			storeKind: "domain-policy",
+			listKey:   "object",
+			listMatch: map[string]string{"object_list": policyListWhitelist},
+		},
+		"policy_bl_domain": {
+			storeKind: "domain-policy",
+			listKey:   "object",
+			listMatch: map[string]string{"object_list": policyListBlacklist},
+		},
+		"mailbox-policy": {
+			addMsg: "mailbox_modified",
+			apply:  mockApplyPolicy("username"),
+		},
+		"policy_wl_mailbox": {
+			storeKind: "mailbox-policy",
+			listKey:   "object",
+			listMatch: map[string]string{"object_list": policyListWhitelist},
+		},
+		"policy_bl_mailbox": {
+			storeKind: "mailbox-policy",
+			listKey:   "object",
+			listMatch: map[string]string{"object_list": policyListBlacklist},
+		},
+		"filter": {
+			addMsg:  "mailbox_modified",
+			getName: "filters",
+			apply:   mockApplyAll,
+		},
+		"resource": {
+			addMsg: "resource_added",
+			idFunc: mockResourceName,
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				if _, ok := object["name"]; !ok {
+					object["name"] = mockResourceName(attr)
+					object["local_part"] = strings.Split(mockResourceName(attr), "@")[0]
+				}
+				mockApplyAll(object, attr)
+				delete(object, "id")
+			},
+		},
+		"time_limited_alias": {
+			addMsg:  "mailbox_modified",
+			getName: "time_limited_aliases",
+			listKey: "goto",
+			idFunc: func(payload map[string]interface{}) string {
+				return randomLowerCaseString(12) + "@" + fmt.Sprint(payload["domain"])
+			},
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				object["address"] = object["id"]
+				object["goto"] = attr["username"]
+				object["description"] = attr["description"]
+				object["validity"] = time.Now().Add(time.Duration(attr["validity"].(float64)) * time.Hour).Unix()
+				delete(object, "id")
+			},
+		},
+		"rl-mbox": {
+			upsert: true,
+			apply:  mockApplyRateLimit,
+		},
+		"rl-domain": {
+			upsert: true,
+			apply:  mockApplyRateLimit,
+		},
+		"recipient_map": {
+			addMsg: "recipient_map_entry_saved",
+			apply:  mockApplyAll,
+		},
+		"syncjob": {
+			addMsg:  "mailbox_modified",
+			getName: "syncjobs",
+			listKey: "user2",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				mockApplyExcept("password1")(object, attr)
+				if attr["username"] != nil {
+					object["user2"] = attr["username"]
+				}
+			},
+		},
+		"oauth2-client": {
+			addMsg: "object_modified",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				object["redirect_uri"] = attr["redirect_uri"]
+				object["client_id"] = fmt.Sprint("client-", object["id"])
+				object["client_secret"] = fmt.Sprint("secret-", object["id"])
+				object["scope"] = "profile"
+			},
+		},
+		"domain-admin": {
+			addMsg: "domain_admin_added",
+			idKey:  "username",
+			apply: func(object map[string]interface{}, attr map[string]interface{}) {
+				for key, value := range attr {
+					switch key {
+					case "password", "password2", "username_new":
+					case "domains":
+						object["selected_domains"] = value
+					case "active":
+						object["active"] = value
+						object["active_int"] = value
+					default:
+						object[key] = value
+					}
+				}
+			},
+		},
+		"da-acl": {
+			storeKind: "domain-admin",
+			apply:     mockApplyAll,
+		},
+		"identity-provider": {
+			singleton: true,
+			apply:     mockApplyAll,
+		},
+	}
+}
+
+// testMockApply plans and applies the configuration raw on top of state.
+func testMockApply(t *testing.T, res *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+	ctx := context.Background()
+	diff, err := res.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	if diff == nil {
+		t.Fatal("diff: no changes")
+	}
+	newState, diags := res.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("apply: %v", diags)
+	}
+	return newState
+}
+
+// testMockApplyWriteOnly plans and applies the configuration raw like terraform does with write-only arguments,
+// which are only sent in the raw configuration and never planned.
+func testMockApplyWriteOnly(t *testing.T, res *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, writeOnly map[string]string, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+	ctx := context.Background()
+	diff, err := res.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	if diff == nil {
+		t.Fatal("diff: no changes")
+	}
+	rawConfig := make(map[string]cty.Value)
+	for name, attributeType := range res.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		rawConfig[name] = cty.NullVal(attributeType)
+	}
+	for name, value := range writeOnly {
+		rawConfig[name] = cty.StringVal(value)
+	}
+	diff.RawConfig = cty.ObjectVal(rawConfig)
+	newState, diags := res.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("apply: %v", diags)
+	}
+	return newState
+}
+
+// testMockApplyError plans and applies the configuration raw and expects an error.
+func testMockApplyError(t *testing.T, res *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) {
+	t.Helper()
+	ctx := context.Background()
+	diff, err := res.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		return
+	}
+	_, diags := res.Apply(ctx, state, diff, meta)
+	if !diags.HasError() {
+		t.Fatal("apply: expected an error")
+	}
+}
+
+// testMockRefresh reads the resource, returns nil if it vanished.
+func testMockRefresh(t *testing.T, res *schema.Resource, state *terraform.InstanceState, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+	newState, diags := res.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("refresh: %v", diags)
+	}
+	return newState
+}
+
+// testMockImport imports the resource by id and reads it.
+func testMockImport(t *testing.T, res *schema.Resource, id string, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+	data := res.Data(&terraform.InstanceState{ID: id})
+	imported, err := res.Importer.StateContext(context.Background(), data, meta)
+	if err != nil {
+		t.Fatalf("import: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("import: expected 1 resource, got %d", len(imported))
+	}
+	state := imported[0].State()
+	if state == nil {
+		t.Fatalf("import: no state for %s", id)
+	}
+	return testMockRefresh(t, res, state, meta)
+}
+
+// testMockReadData reads the data source with the configuration raw.
+func testMockReadData(t *testing.T, res *schema.Resource, raw map[string]interface{}, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+	ctx := context.Background()
+	diff, err := res.Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	state, diags := res.ReadDataApply(ctx, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	return state
+}
+
+// testMockDestroy deletes the resource.
+func testMockDestroy(t *testing.T, res *schema.Resource, state *terraform.InstanceState, meta interface{}) {
+	t.Helper()
+	newState, diags := res.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, meta)
+	if diags.HasError() {
+		t.Fatalf("destroy: %v", diags)
+	}
+	if newState != nil && newState.ID != "" {
+		t.Fatalf("destroy: id not cleared: %s", newState.ID)
+	}
+}
+
+// testMockCheckAttrs compares state attributes with the expected values.
+func testMockCheckAttrs(t *testing.T, state *terraform.InstanceState, expected map[string]string) {
+	t.Helper()
+	if state == nil {
+		t.Fatal("state is nil")
+	}
+	for key, value := range expected {
+		if state.Attributes[key] != value {
+			t.Errorf("attribute %s: expected %q, got %q", key, value, state.Attributes[key])
+		}
+	}
+}
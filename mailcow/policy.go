@@ -0,0 +1,255 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+// the spam policy lists of domains and mailboxes
+const (
+	policyListWhitelist = "wl"
+	policyListBlacklist = "bl"
+)
+
+var policyLists = []string{
+	policyListWhitelist,
+	policyListBlacklist,
+}
+
+// policyEntries are the prefids of the entries of the spam policy lists by list and value
+type policyEntries map[string]map[string]string
+
+// policyScope describes the domain or mailbox the spam policy lists of a policy resource belong to
+type policyScope struct {
+	// argument is the argument of the resource naming the domain or mailbox, its value is the id
+	argument string
+	// key is the payload key of the domain or mailbox the entries are added to
+	key string
+	// nameField is the field mailcow returns for an existing domain or mailbox
+	nameField string
+	getOwner  func(ctx context.Context, c *APIClient, owner string) api.ApiMailcowGetRequest
+	getList   func(ctx context.Context, c *APIClient, list string, owner string) api.ApiMailcowGetAllRequest
+	createReq func() *api.MailcowCreateRequest
+	deleteReq func() *api.MailcowDeleteRequest
+}
+
+// policyResource returns the resource managing the spam policy lists of the scope
+func policyResource(scope policyScope, description string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: scope.create,
+		ReadContext:   scope.read,
+		UpdateContext: scope.update,
+		DeleteContext: scope.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scope.argument: {
+				Type:        schema.TypeString,
+				Description: description,
+				Required:    true,
+				ForceNew:    true,
+			},
+			policyListWhitelist: policyListSchema("senders whose mails are never classified as spam (whitelist), e.g. \"*@example.org\""),
+			policyListBlacklist: policyListSchema("senders whose mails are always rejected as spam (blacklist), e.g. \"*@spam.xyz\""),
+		},
+	}
+}
+
+func resourcePolicyImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func (scope policyScope) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get(scope.argument).(string))
+
+	err := scope.reconcile(ctx, d, wantedPolicyLists(d), m.(*APIClient))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return scope.read(ctx, d, m)
+}
+
+func (scope policyScope) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	owner, err := readRequest(scope.getOwner(ctx, c, id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if owner[scope.nameField] == nil {
+		return removeFromState(d, scope.argument+" policy")
+	}
+
+	entries, err := scope.readEntries(ctx, c, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set(scope.argument, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = setPolicyLists(d, entries)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func (scope policyScope) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := scope.reconcile(ctx, d, wantedPolicyLists(d), m.(*APIClient))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return scope.read(ctx, d, m)
+}
+
+func (scope policyScope) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := scope.reconcile(ctx, d, make(map[string][]string), m.(*APIClient))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// reconcile makes the spam policy lists of the domain or mailbox in mailcow match the wanted ones
+func (scope policyScope) reconcile(ctx context.Context, d *schema.ResourceData, wanted map[string][]string, c *APIClient) error {
+	owner := d.Id()
+
+	entries, err := scope.readEntries(ctx, c, owner)
+	if err != nil {
+		return err
+	}
+
+	return reconcilePolicyEntries(ctx, entries, wanted, scope.key, owner, scope.createReq, scope.deleteReq, c)
+}
+
+func (scope policyScope) readEntries(ctx context.Context, c *APIClient, owner string) (policyEntries, error) {
+	return readPolicyEntries(func(list string) api.ApiMailcowGetAllRequest {
+		return scope.getList(ctx, c, list, owner)
+	})
+}
+
+func policyListSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// readPolicyEntries reads the entries of both spam policy lists
+func readPolicyEntries(getList func(list string) api.ApiMailcowGetAllRequest) (policyEntries, error) {
+	entries := make(policyEntries)
+	for _, list := range policyLists {
+		objects, err := readAllRequest(getList(list))
+		if err != nil {
+			return nil, err
+		}
+		entries[list] = make(map[string]string)
+		for _, object := range objects {
+			entries[list][fmt.Sprint(object["value"])] = fmt.Sprint(object["prefid"])
+		}
+	}
+	return entries, nil
+}
+
+func setPolicyLists(d *schema.ResourceData, entries policyEntries) error {
+	for _, list := range policyLists {
+		values := make([]string, 0, len(entries[list]))
+		for value := range entries[list] {
+			values = append(values, value)
+		}
+		err := d.Set(list, values)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcilePolicyEntries deletes the entries not in the wanted lists and adds the missing ones,
+// ownerKey is the payload key of the domain or mailbox the entries are added to
+func reconcilePolicyEntries(
+	ctx context.Context,
+	entries policyEntries,
+	wanted map[string][]string,
+	ownerKey string,
+	owner string,
+	newCreateRequest func() *api.MailcowCreateRequest,
+	newDeleteRequest func() *api.MailcowDeleteRequest,
+	c *APIClient) error {
+
+	// deleting first allows moving a value from one list to the other
+	for _, list := range policyLists {
+		values := wanted[list]
+		for value, prefid := range entries[list] {
+			if isElementIn(value, &values) {
+				continue
+			}
+			log.Printf("[DEBUG] delete %s entry %s of %s", list, value, owner)
+			mailcowDeleteRequest := newDeleteRequest()
+			mailcowDeleteRequest.SetItem(prefid)
+			response, err := api.MailcowDeleteExecute(ctx, c.client, mailcowDeleteRequest)
+			if err != nil {
+				return err
+			}
+			err = checkResponse(response, mailcowDeleteRequest.ResourceName, owner)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, list := range policyLists {
+		for _, value := range wanted[list] {
+			if _, ok := entries[list][value]; ok {
+				continue
+			}
+			log.Printf("[DEBUG] add %s entry %s of %s", list, value, owner)
+			mailcowCreateRequest := newCreateRequest()
+			mailcowCreateRequest.Set(ownerKey, owner)
+			mailcowCreateRequest.Set("object_list", list)
+			mailcowCreateRequest.Set("object_from", value)
+			request := c.client.Api.MailcowCreate(ctx).MailcowCreateRequest(*mailcowCreateRequest)
+			response, _, err := c.client.Api.MailcowCreateExecute(request)
+			if err != nil {
+				return err
+			}
+			err = checkResponse(response, mailcowCreateRequest.ResourceName, owner)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// wantedPolicyLists returns the values of both spam policy lists in the configuration
+func wantedPolicyLists(d *schema.ResourceData) map[string][]string {
+	wanted := make(map[string][]string)
+	for _, list := range policyLists {
+		wanted[list] = setToStringList(d.Get(list).(*schema.Set))
+	}
+	return wanted
+}
@@ -3,12 +3,16 @@ package mailcow
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/l-with/terraform-provider-mailcow/api"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func Provider() *schema.Provider {
@@ -33,21 +37,80 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("MAILCOW_INSECURE", false),
 				Description: "Whether to skip TLS verification, can optionally be passed as `MAILCOW_INSECURE` environmental variable",
 			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MAILCOW_DEBUG", false),
+				Description: "Whether to log the requests to and the responses of the mailcow API. API keys, passwords and client secrets are masked. The dumps are logged at `DEBUG` level and only shown with `TF_LOG=DEBUG` or `TF_LOG=TRACE`. Can optionally be passed as `MAILCOW_DEBUG` environmental variable",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MAILCOW_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How often a request failing with a server error, rate limit or connection error is retried, `0` disables retries. Adding and deleting objects is not idempotent and only retried if mailcow did not process the request. Can optionally be passed as `MAILCOW_MAX_RETRIES` environmental variable",
+			},
+			"retry_wait_min": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MAILCOW_RETRY_WAIT_MIN", "1s"),
+				ValidateDiagFunc: validateDurationDiag,
+				Description:      "The wait before the first retry (e.g. `500ms`, `1s`), doubled with jitter on every further retry. A `Retry-After` header sent by mailcow takes precedence, capped by `retry_wait_max`. Can optionally be passed as `MAILCOW_RETRY_WAIT_MIN` environmental variable",
+			},
+			"retry_wait_max": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MAILCOW_RETRY_WAIT_MAX", "30s"),
+				ValidateDiagFunc: validateDurationDiag,
+				Description:      "The maximum wait between two retries (e.g. `30s`), also caps a longer wait asked for by a `Retry-After` header. `0s` disables the cap. Can optionally be passed as `MAILCOW_RETRY_WAIT_MAX` environmental variable",
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MAILCOW_MAX_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum rate of requests sent to the mailcow API by all resources and data sources, the requests are evenly spaced. `0` is unlimited. Can optionally be passed as `MAILCOW_MAX_REQUESTS_PER_SECOND` environmental variable",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MAILCOW_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests in flight to the mailcow API across all resources and data sources. `0` is unlimited. Can optionally be passed as `MAILCOW_MAX_CONCURRENT_REQUESTS` environmental variable",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"mailcow_alias":                      resourceAlias(),
+			"mailcow_app_password":               resourceAppPassword(),
+			"mailcow_bcc_map":                    resourceBccMap(),
 			"mailcow_domain":                     resourceDomain(),
+			"mailcow_domain_admin":               resourceDomainAdmin(),
 			"mailcow_domain_alias":               resourceDomainAlias(),
+			"mailcow_domain_policy":              resourceDomainPolicy(),
 			"mailcow_identity_provider_keycloak": resourceIdentityProviderKeycloak(),
 			"mailcow_mailbox":                    resourceMailbox(),
+			"mailcow_mailbox_policy":             resourceMailboxPolicy(),
+			"mailcow_ratelimit":                  resourceRateLimit(),
+			"mailcow_recipient_map":              resourceRecipientMap(),
+			"mailcow_relayhost":                  resourceRelayhost(),
+			"mailcow_resource":                   resourceResource(),
+			"mailcow_sieve_filter":               resourceSieveFilter(),
 			"mailcow_dkim":                       resourceDkim(),
 			"mailcow_syncjob":                    resourceSyncjob(),
+			"mailcow_time_limited_alias":         resourceTimeLimitedAlias(),
+			"mailcow_tls_policy_map":             resourceTlsPolicyMap(),
+			"mailcow_transport":                  resourceTransport(),
 			"mailcow_oauth2_client":              resourceOAuth2Client(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"mailcow_domain":  dataSourceDomain(),
-			"mailcow_mailbox": dataSourceMailbox(),
-			"mailcow_dkim":    dataSourceDkim(),
+			"mailcow_aliases":        dataSourceAliases(),
+			"mailcow_domain":         dataSourceDomain(),
+			"mailcow_domains":        dataSourceDomains(),
+			"mailcow_mailbox":        dataSourceMailbox(),
+			"mailcow_mailboxes":      dataSourceMailboxes(),
+			"mailcow_resource":       dataSourceResource(),
+			"mailcow_syncjob_status": dataSourceSyncjobStatus(),
+			"mailcow_dkim":           dataSourceDkim(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -62,6 +125,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	hostName := d.Get("host_name").(string)
 	apiKey := d.Get("api_key").(string)
 	insecure := d.Get("insecure").(bool)
+	// the durations are validated by the schema
+	retryWaitMin, _ := time.ParseDuration(d.Get("retry_wait_min").(string))
+	retryWaitMax, _ := time.ParseDuration(d.Get("retry_wait_max").(string))
 
 	config := api.NewConfiguration()
 
@@ -70,7 +136,12 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	config.Scheme = "https"
 	config.AddDefaultHeader("X-API-Key", apiKey)
 	config.AddDefaultHeader("accept", "application/json")
-	config.Debug = true
+	config.Debug = d.Get("debug").(bool)
+	config.MaxRetries = d.Get("max_retries").(int)
+	config.RetryWaitMin = retryWaitMin
+	config.RetryWaitMax = retryWaitMax
+	config.MaxRequestsPerSecond = d.Get("max_requests_per_second").(float64)
+	config.MaxConcurrentRequests = d.Get("max_concurrent_requests").(int)
 
 	customTransport := http.DefaultTransport.(*http.Transport).Clone() // make shallow copy
 	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
@@ -86,3 +157,16 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		client: apiClient,
 	}, diags
 }
+
+func validateDurationDiag(v any, _ cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil || duration < 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Invalid duration '%s'", v),
+			Detail:   "The value must be a non-negative duration with unit, e.g. \"500ms\", \"1s\" or \"1m\".",
+		})
+	}
+	return diags
+}
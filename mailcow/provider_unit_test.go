@@ -0,0 +1,239 @@
+package mailcow
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProviderRetryMock(t *testing.T) {
+	testCases := []struct {
+		name       string
+		operation  string
+		failures   []mockMailcowFailure
+		maxRetries int
+		// retryWaitMax caps the wait asked for by Retry-After, the mock default is 10ms
+		retryWaitMax string
+		expectErr    bool
+		requests     int
+	}{
+		{
+			name:       "read retried on server error",
+			operation:  "read",
+			failures:   []mockMailcowFailure{{statusCode: http.StatusBadGateway}, {statusCode: http.StatusInternalServerError}},
+			maxRetries: 3,
+			requests:   3,
+		},
+		{
+			name:       "read fails after retries",
+			operation:  "read",
+			failures:   []mockMailcowFailure{{statusCode: http.StatusBadGateway}, {statusCode: http.StatusBadGateway}, {statusCode: http.StatusBadGateway}},
+			maxRetries: 2,
+			expectErr:  true,
+			requests:   3,
+		},
+		{
+			name:       "retries disabled",
+			operation:  "read",
+			failures:   []mockMailcowFailure{{statusCode: http.StatusServiceUnavailable}},
+			maxRetries: 0,
+			expectErr:  true,
+			requests:   1,
+		},
+		{
+			name:         "add retried on rate limit",
+			operation:    "create",
+			failures:     []mockMailcowFailure{{statusCode: http.StatusTooManyRequests, retryAfter: "1"}},
+			maxRetries:   3,
+			retryWaitMax: "2s",
+			requests:     2,
+		},
+		{
+			name:       "add not retried on bad gateway",
+			operation:  "create",
+			failures:   []mockMailcowFailure{{statusCode: http.StatusBadGateway}},
+			maxRetries: 3,
+			expectErr:  true,
+			requests:   1,
+		},
+	}
+
+	config := map[string]interface{}{
+		"address": "alias@440044.xyz",
+		"goto":    "demo@440044.xyz",
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := newMockMailcow(t)
+			arguments := map[string]interface{}{"max_retries": tc.maxRetries}
+			if tc.retryWaitMax != "" {
+				arguments["retry_wait_max"] = tc.retryWaitMax
+			}
+			meta := mock.metaWith(arguments)
+			res := resourceAlias()
+
+			var state *terraform.InstanceState
+			if tc.operation == "read" {
+				state = testMockApply(t, res, nil, config, meta)
+			}
+			mock.fail(tc.failures...)
+			before := mock.requestCount()
+
+			start := time.Now()
+			var err bool
+			if tc.operation == "read" {
+				_, diags := res.RefreshWithoutUpgrade(t.Context(), state, meta)
+				err = diags.HasError()
+			} else {
+				diff, _ := res.Diff(t.Context(), nil, terraform.NewResourceConfigRaw(config), meta)
+				_, diags := res.Apply(t.Context(), nil, diff, meta)
+				err = diags.HasError()
+			}
+			if err != tc.expectErr {
+				t.Errorf("expected error %v, got %v", tc.expectErr, err)
+			}
+			if requests := mock.requestCount() - before; requests != tc.requests {
+				t.Errorf("expected %d requests, got %d", tc.requests, requests)
+			}
+			for _, failure := range tc.failures {
+				if failure.retryAfter != "" && time.Since(start) < time.Second {
+					t.Errorf("Retry-After not respected, retried after %s", time.Since(start))
+				}
+			}
+		})
+	}
+}
+
+func TestProviderRetryAfterCappedMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.metaWith(map[string]interface{}{"max_retries": 2, "retry_wait_max": "100ms"})
+	res := resourceAlias()
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"address": "alias@440044.xyz",
+		"goto":    "demo@440044.xyz",
+	}, meta)
+
+	mock.fail(mockMailcowFailure{statusCode: http.StatusServiceUnavailable, retryAfter: "3600"})
+	start := time.Now()
+	_, diags := res.RefreshWithoutUpgrade(t.Context(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	if wait := time.Since(start); wait > 10*time.Second {
+		t.Errorf("Retry-After not capped by retry_wait_max, retried after %s", wait)
+	}
+}
+
+func TestProviderRetryWaitValidation(t *testing.T) {
+	for _, value := range []string{"", "10", "10x", "-1s"} {
+		if diags := validateDurationDiag(value, nil); !diags.HasError() {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+	for _, value := range []string{"0s", "500ms", "1m"} {
+		if diags := validateDurationDiag(value, nil); diags.HasError() {
+			t.Errorf("expected %q to be valid: %v", value, diags)
+		}
+	}
+}
+
+func TestProviderThrottleMock(t *testing.T) {
+	testCases := []struct {
+		name          string
+		arguments     map[string]interface{}
+		maxInFlight   int32
+		minimumPeriod time.Duration
+	}{
+		{
+			name:        "concurrency limit",
+			arguments:   map[string]interface{}{"max_concurrent_requests": 2},
+			maxInFlight: 2,
+		},
+		{
+			name:          "rate limit",
+			arguments:     map[string]interface{}{"max_requests_per_second": 50.0},
+			maxInFlight:   8,
+			minimumPeriod: 7 * 20 * time.Millisecond,
+		},
+	}
+
+	config := map[string]interface{}{
+		"address": "alias@440044.xyz",
+		"goto":    "demo@440044.xyz",
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := newMockMailcow(t)
+			res := resourceAlias()
+			state := testMockApply(t, res, nil, config, mock.meta())
+
+			meta := mock.metaWith(tc.arguments)
+			mock.delay = 10 * time.Millisecond
+
+			start := time.Now()
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, diags := res.RefreshWithoutUpgrade(t.Context(), state, meta)
+					if diags.HasError() {
+						t.Errorf("refresh: %v", diags)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if maxInFlight := mock.maxInFlight.Load(); maxInFlight > tc.maxInFlight {
+				t.Errorf("expected at most %d requests in flight, got %d", tc.maxInFlight, maxInFlight)
+			}
+			if elapsed := time.Since(start); elapsed < tc.minimumPeriod {
+				t.Errorf("expected requests to take at least %s, took %s", tc.minimumPeriod, elapsed)
+			}
+		})
+	}
+}
+
+func TestProviderDebugMock(t *testing.T) {
+	for _, debug := range []bool{false, true} {
+		t.Run(fmt.Sprint("debug=", debug), func(t *testing.T) {
+			var output bytes.Buffer
+			log.SetOutput(&output)
+			defer log.SetOutput(os.Stderr)
+
+			mock := newMockMailcow(t)
+			meta := mock.metaWith(map[string]interface{}{"debug": debug})
+			testMockApply(t, resourceMailbox(), nil, map[string]interface{}{
+				"domain":     "440044.xyz",
+				"local_part": "demo",
+				"password":   "secret-password",
+			}, meta)
+			testMockApply(t, resourceIdentityProviderKeycloak(), nil, map[string]interface{}{
+				"server_url":    "https://auth.440044.xyz",
+				"realm":         "mailcow",
+				"client_id":     "mailcow",
+				"client_secret": "secret-client",
+				"redirect_url":  "https://mail.440044.xyz",
+				"version":       "26.1.0",
+			}, meta)
+
+			logged := output.String()
+			if strings.Contains(logged, "mailcow API request") != debug {
+				t.Errorf("expected requests to be logged: %t", debug)
+			}
+			for _, secret := range []string{"mock-api-key", "secret-password", "secret-client"} {
+				if strings.Contains(logged, secret) {
+					t.Errorf("secret %q logged:\n%s", secret, logged)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package mailcow
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// rateLimitPattern matches mailcow rate limits, a decimal value with the time frame s, m, h or d
+var rateLimitPattern = regexp.MustCompile(`^([0-9]+)([smhd])$`)
+
+// rateLimit is a mailcow rate limit like "10s", sent to mailcow as rl_value and rl_frame
+type rateLimit struct {
+	value int
+	frame string
+}
+
+func parseRateLimit(s string) (*rateLimit, error) {
+	match := rateLimitPattern.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("invalid rate limit '%s', expected a decimal value with unit s, m, h or d, e.g. \"10s\"", s)
+	}
+	value, err := strconv.Atoi(match[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit '%s': %w", s, err)
+	}
+	return &rateLimit{value: value, frame: match[2]}, nil
+}
+
+func (r rateLimit) String() string {
+	return fmt.Sprint(r.value, r.frame)
+}
+
+// rateLimitString converts the rate limit returned by mailcow ({"value": ..., "frame": ...}, false if none) to "10s", "" if none
+func rateLimitString(rl interface{}) string {
+	if rl == nil || reflect.ValueOf(rl).Kind() != reflect.Map {
+		return ""
+	}
+	value := reflect.ValueOf(rl)
+	fields := make(map[string]string)
+	for _, key := range value.MapKeys() {
+		fields[fmt.Sprint(key)] = fmt.Sprint(value.MapIndex(key))
+	}
+	if fields["value"] == "" || fields["value"] == "<nil>" {
+		return ""
+	}
+	return fields["value"] + fields["frame"]
+}
+
+func validateRateLimitDiag(v any, _ cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	_, err := parseRateLimit(v.(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Invalid rate limit '%s'", v),
+			Detail:   "The value must be a decimal value with unit s, m, h or d, e.g. \"10s\" or \"100h\".",
+		})
+	}
+	return diags
+}
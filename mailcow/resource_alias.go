@@ -2,7 +2,6 @@ package mailcow
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -106,10 +105,41 @@ func boolToInt(b bool) int {
 	return 0
 }
 
-func resourceAliasImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+// resourceAliasImport imports an alias by id or by address, "@domain.tld" for a catchall
+func resourceAliasImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), "@") {
+		return []*schema.ResourceData{d}, nil
+	}
+	c := m.(*APIClient)
+	id, err := getAliasId(ctx, c, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
 	return []*schema.ResourceData{d}, nil
 }
 
+// getAliasId returns the id of the alias with the address, mailcow only gets aliases by id
+func getAliasId(ctx context.Context, c *APIClient, address string) (string, error) {
+	aliases, err := readAllRequest(c.client.Api.MailcowGetAliases(ctx))
+	if err != nil {
+		return "", err
+	}
+	ids := make([]string, 0, 1)
+	for _, alias := range aliases {
+		if strings.EqualFold(fmt.Sprint(alias["address"]), address) {
+			ids = append(ids, fmt.Sprint(alias["id"]))
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("alias '%s' not found", address)
+	case 1:
+		return ids[0], nil
+	}
+	return "", fmt.Errorf("alias '%s' is ambiguous, import it by one of the ids %s", address, strings.Join(ids, ", "))
+}
+
 func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -166,7 +196,7 @@ func resourceAliasRead(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 
 	if alias["id"] == nil {
-		return diag.FromErr(errors.New("alias id not found: " + id))
+		return removeFromState(d, "alias")
 	}
 
 	err = setResourceData(resourceAlias(), d, &alias, nil, nil)
@@ -0,0 +1,87 @@
+package mailcow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceAliasMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceAlias()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"address": "alias@440044.xyz",
+		"goto":    "demo@440044.xyz",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":           "1",
+		"address":      "alias@440044.xyz",
+		"goto":         "demo@440044.xyz",
+		"active":       "true",
+		"sogo_visible": "false",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"address": "alias@440044.xyz",
+		"goto":    "demo@440044.xyz",
+		"active":  "true",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"address":      "alias@440044.xyz",
+		"goto":         gotoSpamDestination,
+		"sogo_visible": true,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"goto":         gotoSpamDestination,
+		"sogo_visible": "true",
+	})
+
+	for _, importId := range []string{state.ID, "alias@440044.xyz", "Alias@440044.xyz"} {
+		imported := testMockImport(t, res, importId, meta)
+		testMockCheckAttrs(t, imported, map[string]string{
+			"id":           state.ID,
+			"address":      "alias@440044.xyz",
+			"goto":         gotoSpamDestination,
+			"sogo_visible": "true",
+		})
+	}
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("alias", state.ID) != nil {
+		t.Fatal("alias not deleted")
+	}
+}
+
+func TestResourceAliasImportMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceAlias()
+
+	catchall := testMockApply(t, res, nil, map[string]interface{}{
+		"address": "@440044.xyz",
+		"goto":    "demo@440044.xyz",
+	}, meta)
+	imported := testMockImport(t, res, "@440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"id":      catchall.ID,
+		"address": "@440044.xyz",
+		"goto":    "demo@440044.xyz",
+	})
+
+	_, err := res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "unknown@440044.xyz"}), meta)
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected an error importing an unknown alias, got %v", err)
+	}
+
+	// mailcow keeps addresses unique, but a second alias with the same address must not be imported by chance
+	mock.put("alias", "42", map[string]interface{}{"id": 42, "address": "@440044.xyz", "goto": "other@440044.xyz"})
+	_, err = res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "@440044.xyz"}), meta)
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an error importing an ambiguous alias, got %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+// appPasswordProtocols are the protocols an app password can grant access to, mailcow names them <protocol>_access
+var appPasswordProtocols = []string{
+	"imap",
+	"pop3",
+	"smtp",
+	"sieve",
+	"dav",
+	"eas",
+}
+
+const appPasswordLength = 32
+
+func resourceAppPassword() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppPasswordCreate,
+		ReadContext:   resourceAppPasswordRead,
+		UpdateContext: resourceAppPasswordUpdate,
+		DeleteContext: resourceAppPasswordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAppPasswordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mailbox": {
+				Type:        schema.TypeString,
+				Description: "e-mail address of the mailbox the app password belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"app_name": {
+				Type:        schema.TypeString,
+				Description: "name of the application using the app password",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "the app password, generated if not set (mailcow does not return it, an imported app password has none)",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"protocols": {
+				Type:        schema.TypeSet,
+				Description: "protocols the app password grants access to. Any of: imap, pop3, smtp, sieve, dav, eas.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(appPasswordProtocols, false),
+				},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is app password active or not",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// resourceAppPasswordImport accepts "mailbox/id"
+func resourceAppPasswordImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	mailbox, id, ok := strings.Cut(d.Id(), "/")
+	if !ok || mailbox == "" || id == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected mailbox/id", d.Id())
+	}
+	err := d.Set("mailbox", mailbox)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAppPasswordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailbox := d.Get("mailbox").(string)
+	appName := d.Get("app_name").(string)
+
+	password := d.Get("password").(string)
+	if password == "" {
+		var err error
+		password, err = randomPassword(appPasswordLength)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("password", password)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	mailcowCreateRequest := api.NewCreateAppPasswordRequest()
+	mailcowCreateRequest.Set("username", mailbox)
+	mailcowCreateRequest.Set("app_passwd", password)
+	mailcowCreateRequest.Set("app_passwd2", password)
+	mailcowCreateRequest.Set("protocols", appPasswordProtocolsAccess(d.Get("protocols").(*schema.Set)))
+
+	exclude := []string{"mailbox", "password", "protocols"}
+	err := mailcowCreate(ctx, resourceAppPassword(), d, mailbox+"/"+appName, &exclude, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new app password, the newest one with the name is it
+	appPasswords, err := readAllRequest(c.client.Api.MailcowGetAppPasswords(ctx, mailbox))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, ok := newestId(appPasswords, func(appPassword map[string]interface{}) bool {
+		return appPassword["name"] == appName
+	})
+	if !ok {
+		return diag.Errorf("app password %s of mailbox %s not found", appName, mailbox)
+	}
+	d.SetId(id)
+
+	return resourceAppPasswordRead(ctx, d, m)
+}
+
+func resourceAppPasswordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+	mailbox := d.Get("mailbox").(string)
+
+	appPasswords, err := readAllRequest(c.client.Api.MailcowGetAppPasswords(ctx, mailbox))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var appPassword map[string]interface{}
+	for _, candidate := range appPasswords {
+		if fmt.Sprint(candidate["id"]) == id {
+			appPassword = candidate
+			break
+		}
+	}
+	if appPassword == nil {
+		return removeFromState(d, "app password")
+	}
+
+	appPassword["app_name"] = appPassword["name"]
+	exclude := []string{"mailbox", "password", "protocols"}
+	err = setResourceData(resourceAppPassword(), d, &appPassword, &exclude, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	protocols := make([]string, 0)
+	for _, protocol := range appPasswordProtocols {
+		if fmt.Sprint(appPassword[protocol+"_access"]) == "1" {
+			protocols = append(protocols, protocol)
+		}
+	}
+	err = d.Set("protocols", protocols)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceAppPasswordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateAppPasswordRequest()
+
+	// mailcow replaces the protocols on every edit, so they always have to be sent
+	mailcowUpdateRequest.SetAttr("protocols", appPasswordProtocolsAccess(d.Get("protocols").(*schema.Set)))
+	if d.HasChange("password") {
+		mailcowUpdateRequest.SetAttr("app_passwd", d.Get("password"))
+		mailcowUpdateRequest.SetAttr("app_passwd2", d.Get("password"))
+	}
+
+	exclude := []string{"mailbox", "password", "protocols"}
+	err := mailcowUpdate(ctx, resourceAppPassword(), d, &exclude, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAppPasswordRead(ctx, d, m)
+}
+
+func resourceAppPasswordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteAppPasswordRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
+
+// appPasswordProtocolsAccess converts the protocols to the mailcow names <protocol>_access
+func appPasswordProtocolsAccess(protocols *schema.Set) []string {
+	access := make([]string, 0, protocols.Len())
+	for _, protocol := range setToStringList(protocols) {
+		access = append(access, protocol+"_access")
+	}
+	return access
+}
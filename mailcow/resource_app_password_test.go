@@ -0,0 +1,66 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceAppPassword(t *testing.T) {
+	domain := fmt.Sprintf("with-app-password-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	localPart := fmt.Sprintf("with-app-password-%s", randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAppPassword(domain, localPart, `["imap", "smtp"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_app_password.app_password", "app_name", "ci"),
+					resource.TestCheckResourceAttr("mailcow_app_password.app_password", "protocols.#", "2"),
+					resource.TestCheckResourceAttrSet("mailcow_app_password.app_password", "password"),
+				),
+			},
+			{
+				Config: testAccResourceAppPassword(domain, localPart, `["dav"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_app_password.app_password", "protocols.#", "1"),
+				),
+			},
+			{
+				ResourceName: "mailcow_app_password.app_password",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					appPassword := s.RootModule().Resources["mailcow_app_password.app_password"].Primary
+					return appPassword.Attributes["mailbox"] + "/" + appPassword.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccResourceAppPassword(domain string, localPart string, protocols string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  local_part = "%[2]s"
+  domain     = mailcow_domain.domain.id
+  password   = "secret-password"
+  full_name  = "app password"
+}
+
+resource "mailcow_app_password" "app_password" {
+  mailbox   = mailcow_mailbox.mailbox.address
+  app_name  = "ci"
+  protocols = %[3]s
+}
+`, domain, localPart, protocols)
+}
@@ -0,0 +1,81 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceAppPasswordMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceAppPassword()
+
+	// an app password of another app gets another id
+	testMockApply(t, res, nil, map[string]interface{}{
+		"mailbox":   "demo@440044.xyz",
+		"app_name":  "other",
+		"password":  "other-password",
+		"protocols": []interface{}{"imap"},
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"mailbox":   "demo@440044.xyz",
+		"app_name":  "ci",
+		"protocols": []interface{}{"imap", "smtp"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":          "2",
+		"app_name":    "ci",
+		"active":      "true",
+		"protocols.#": "2",
+	})
+	if password := state.Attributes["password"]; len(password) != appPasswordLength {
+		t.Errorf("expected a generated password of length %d, got %q", appPasswordLength, password)
+	}
+	if access := mock.get("app-passwd", "2")["smtp_access"]; access != "1" {
+		t.Errorf("smtp_access not sent: %v", access)
+	}
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"mailbox":     "demo@440044.xyz",
+		"app_name":    "ci",
+		"protocols.#": "2",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"mailbox":   "demo@440044.xyz",
+		"app_name":  "ci-bot",
+		"password":  "secret-password",
+		"protocols": []interface{}{"dav", "eas", "sieve"},
+		"active":    false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":          "2",
+		"app_name":    "ci-bot",
+		"password":    "secret-password",
+		"active":      "false",
+		"protocols.#": "3",
+	})
+	if access := mock.get("app-passwd", "2")["smtp_access"]; access != "0" {
+		t.Errorf("smtp_access not revoked: %v", access)
+	}
+
+	imported := testMockImport(t, res, "demo@440044.xyz/2", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"id":          "2",
+		"mailbox":     "demo@440044.xyz",
+		"app_name":    "ci-bot",
+		"active":      "false",
+		"protocols.#": "3",
+	})
+
+	_, err := res.Importer.StateContext(t.Context(), res.Data(nil), meta)
+	if err == nil {
+		t.Error("expected an error importing an id without mailbox")
+	}
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("app-passwd", "2") != nil {
+		t.Fatal("app password not deleted")
+	}
+}
@@ -0,0 +1,133 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+const (
+	bccMapTypeSender    = "sender"
+	bccMapTypeRecipient = "rcpt"
+)
+
+func resourceBccMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBccMapCreate,
+		ReadContext:   resourceBccMapRead,
+		UpdateContext: resourceBccMapUpdate,
+		DeleteContext: resourceBccMapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBccMapImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"local_dest": {
+				Type:        schema.TypeString,
+				Description: "local domain or mailbox whose mails are copied",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bcc_dest": {
+				Type:        schema.TypeString,
+				Description: "e-mail address the copies are sent to",
+				Required:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "whether the mails sent by (sender) or sent to (rcpt) local_dest are copied. One of: sender, rcpt.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{bccMapTypeSender, bccMapTypeRecipient}, false),
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is bcc map active or not",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceBccMapImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceBccMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	localDest := d.Get("local_dest").(string)
+	bccType := d.Get("type").(string)
+
+	mailcowCreateRequest := api.NewCreateBccMapRequest()
+
+	err := mailcowCreate(ctx, resourceBccMap(), d, localDest, nil, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new bcc map, but there is only one per local_dest and type
+	bccMaps, err := readAllRequest(c.client.Api.MailcowGetBccMaps(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, bccMap := range bccMaps {
+		if bccMap["local_dest"] == localDest && bccMap["type"] == bccType {
+			d.SetId(fmt.Sprint(bccMap["id"]))
+			return resourceBccMapRead(ctx, d, m)
+		}
+	}
+	return diag.Errorf("bcc map of %s (%s) not found", localDest, bccType)
+}
+
+func resourceBccMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request := c.client.Api.MailcowGetBccMap(ctx, id)
+
+	bccMap, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if bccMap["id"] == nil {
+		return removeFromState(d, "bcc map")
+	}
+
+	err = setResourceData(resourceBccMap(), d, &bccMap, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceBccMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateBccMapRequest()
+
+	err := mailcowUpdate(ctx, resourceBccMap(), d, nil, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBccMapRead(ctx, d, m)
+}
+
+func resourceBccMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteBccMapRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
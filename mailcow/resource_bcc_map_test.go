@@ -0,0 +1,54 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceBccMap(t *testing.T) {
+	domain := fmt.Sprintf("with-bcc-map-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBccMap(domain, "archive@440044.xyz", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_bcc_map.bcc_map", "local_dest", domain),
+					resource.TestCheckResourceAttr("mailcow_bcc_map.bcc_map", "bcc_dest", "archive@440044.xyz"),
+					resource.TestCheckResourceAttr("mailcow_bcc_map.bcc_map", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceBccMap(domain, "legal@440044.xyz", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_bcc_map.bcc_map", "bcc_dest", "legal@440044.xyz"),
+					resource.TestCheckResourceAttr("mailcow_bcc_map.bcc_map", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_bcc_map.bcc_map",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceBccMap(domain string, bccDest string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_bcc_map" "bcc_map" {
+  local_dest = mailcow_domain.domain.domain
+  bcc_dest   = "%[2]s"
+  type       = "sender"
+  active     = %[3]s
+}
+`, domain, bccDest, active)
+}
@@ -0,0 +1,60 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceBccMapMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceBccMap()
+
+	// the bcc map of the other type gets another id
+	testMockApply(t, res, nil, map[string]interface{}{
+		"local_dest": "440044.xyz",
+		"bcc_dest":   "archive@440044.xyz",
+		"type":       "rcpt",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"local_dest": "440044.xyz",
+		"bcc_dest":   "archive@440044.xyz",
+		"type":       "sender",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":     "2",
+		"type":   "sender",
+		"active": "true",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"local_dest": "440044.xyz",
+		"bcc_dest":   "archive@440044.xyz",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"local_dest": "440044.xyz",
+		"bcc_dest":   "legal@440044.xyz",
+		"type":       "sender",
+		"active":     false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":       "2",
+		"bcc_dest": "legal@440044.xyz",
+		"active":   "false",
+	})
+
+	imported := testMockImport(t, res, "2", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"local_dest": "440044.xyz",
+		"bcc_dest":   "legal@440044.xyz",
+		"type":       "sender",
+		"active":     "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("bcc", "2") != nil {
+		t.Fatal("bcc map not deleted")
+	}
+}
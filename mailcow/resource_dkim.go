@@ -88,6 +88,10 @@ func resourceDkimRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.FromErr(err)
 	}
 
+	if len(dkim) == 0 {
+		return removeFromState(d, "dkim")
+	}
+
 	dkim["domain"] = id
 
 	err = setResourceData(resourceDkim(), d, &dkim, nil, nil)
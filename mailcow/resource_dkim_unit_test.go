@@ -0,0 +1,53 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceDkimMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceDkim()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"domain": "440044.xyz",
+		"length": 2048,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":            "440044.xyz",
+		"length":        "2048",
+		"dkim_selector": "dkim",
+	})
+	if state.Attributes["dkim_txt"] == "" {
+		t.Error("dkim_txt not set")
+	}
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"domain": "440044.xyz",
+		"length": "2048",
+	})
+
+	// every argument forces a new dkim key, so an update replaces the key
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"domain":        "440044.xyz",
+		"length":        1024,
+		"dkim_selector": "mail",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"length":        "1024",
+		"dkim_selector": "mail",
+	})
+
+	imported := testMockImport(t, res, "440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"domain":        "440044.xyz",
+		"length":        "1024",
+		"dkim_selector": "mail",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("dkim", "440044.xyz") != nil {
+		t.Fatal("dkim not deleted")
+	}
+}
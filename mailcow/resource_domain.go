@@ -2,13 +2,10 @@ package mailcow
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/l-with/terraform-provider-mailcow/api"
 	"log"
-	"reflect"
 	"strconv"
 )
 
@@ -84,6 +81,7 @@ func resourceDomain() *schema.Resource {
 				Default:     10240,
 				Optional:    true,
 			},
+			"relayhost": relayhostSchema(),
 			"relay_all_recipients": {
 				Type:        schema.TypeBool,
 				Description: "if not, them you have to create \"dummy\" mailbox for each address to relay",
@@ -97,10 +95,11 @@ func resourceDomain() *schema.Resource {
 				Optional:    true,
 			},
 			"rate_limit": {
-				Type:        schema.TypeString,
-				Description: "rate limit, decimal with unit s,m,h,d",
-				Default:     "10s",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "rate limit, decimal with unit s,m,h,d",
+				Default:          "10s",
+				Optional:         true,
+				ValidateDiagFunc: validateRateLimitDiag,
 			},
 			"restart_sogo": {
 				Type:        schema.TypeBool,
@@ -125,17 +124,15 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	mailcowCreateRequest := api.NewCreateDomainRequest()
 
-	exclude := []string{"rate_limit"}
+	exclude := []string{"rate_limit", "relayhost"}
 	value, ok := d.GetOk("rate_limit")
 	if ok {
-		rateLimit := value.(string)
-		rateFrame := rateLimit[len(rateLimit)-1:]
-		mailcowCreateRequest.Set("rl_frame", rateFrame)
-		rateValue, err := strconv.Atoi(rateLimit[0 : len(rateLimit)-1])
+		rl, err := parseRateLimit(value.(string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		mailcowCreateRequest.Set("rl_value", float32(rateValue))
+		mailcowCreateRequest.Set("rl_frame", rl.frame)
+		mailcowCreateRequest.Set("rl_value", rl.value)
 	}
 	mailcowCreateRequest.Set("dkim_selector", "0")
 
@@ -146,6 +143,12 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	d.SetId(domain)
+
+	err = updateRelayhost(ctx, d, api.NewUpdateDomainRequest(), c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -164,7 +167,7 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 	}
 
 	if domain["domain_name"] == nil {
-		return diag.FromErr(errors.New("domain not found: " + id))
+		return removeFromState(d, "domain")
 	}
 
 	domain["aliases"] = domain["max_num_aliases_for_domain"]
@@ -174,17 +177,7 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 	domain["mailboxes"] = domain["max_num_mboxes_for_domain"]
 	domain["maxquota"] = int(domain["max_quota_for_mbox"].(float64)) / (1024 * 1024)
 	domain["quota"] = int(domain["max_quota_for_domain"].(float64)) / (1024 * 1024)
-	domainRl := domain["rl"]
-	if domainRl != nil {
-		if reflect.ValueOf(domainRl).Kind() != reflect.Bool {
-			rl := make(map[string]string)
-			value := reflect.ValueOf(domainRl)
-			for _, key := range value.MapKeys() {
-				rl[fmt.Sprint(key)] = fmt.Sprint(value.MapIndex(key))
-			}
-			domain["rate_limit"] = rl["value"] + rl["frame"]
-		}
-	}
+	domain["rate_limit"] = rateLimitString(domain["rl"])
 	domain["restart_sogo"], err = strconv.ParseBool(d.State().Attributes["restart_sogo"])
 	if err != nil {
 		domain["restart_sogo"] = false
@@ -206,15 +199,12 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	mailcowUpdateRequest := api.NewUpdateDomainRequest()
 
 	if d.HasChange("rate_limit") {
-		iRateLimit := d.Get("rate_limit")
-		rateLimit := iRateLimit.(string)
-		rateFrame := rateLimit[len(rateLimit)-1:]
-		mailcowUpdateRequest.SetAttr("rl_frame", rateFrame)
-		rateValue, err := strconv.Atoi(rateLimit[0 : len(rateLimit)-1])
+		rl, err := parseRateLimit(d.Get("rate_limit").(string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		mailcowUpdateRequest.SetAttr("rl_value", float32(rateValue))
+		mailcowUpdateRequest.SetAttr("rl_frame", rl.frame)
+		mailcowUpdateRequest.SetAttr("rl_value", rl.value)
 	}
 
 	updateExclude := []string{
@@ -0,0 +1,202 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+var domainAdminAcls = []string{
+	"syncjobs",
+	"quarantine",
+	"login_as",
+	"sogo_access",
+	"app_passwds",
+	"bcc_maps",
+	"pushover",
+	"filters",
+	"ratelimit",
+	"spam_policy",
+	"extend_sender_acl",
+	"unlimited_quota",
+	"protocol_access",
+	"smtp_ip_access",
+	"alias_domains",
+	"mailbox_relayhost",
+	"domain_relayhost",
+	"domain_desc",
+}
+
+func resourceDomainAdmin() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainAdminCreate,
+		ReadContext:   resourceDomainAdminRead,
+		UpdateContext: resourceDomainAdminUpdate,
+		DeleteContext: resourceDomainAdminDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDomainAdminImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "login name of the domain administrator",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "password of the domain administrator",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"domains": {
+				Type:        schema.TypeSet,
+				Description: "domains managed by the domain administrator",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is domain administrator active or not",
+				Default:     true,
+				Optional:    true,
+			},
+			"acl": {
+				Type:        schema.TypeSet,
+				Description: "permissions granted to the domain administrator (the mailcow API does not return them, they are only written)",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(domainAdminAcls, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainAdminImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDomainAdminCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowCreateRequest := api.NewCreateDomainAdminRequest()
+
+	username := d.Get("username").(string)
+	exclude := []string{"domains", "acl"}
+	mailcowCreateRequest.Set("domains", setToStringList(d.Get("domains").(*schema.Set)))
+	mailcowCreateRequest.Set("password2", d.Get("password"))
+
+	err := mailcowCreate(ctx, resourceDomainAdmin(), d, username, &exclude, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(username)
+
+	if _, ok := d.GetOk("acl"); ok {
+		err = domainAdminUpdateAcl(ctx, d, c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDomainAdminRead(ctx, d, m)
+}
+
+func resourceDomainAdminRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request := c.client.Api.MailcowGetDomainAdmin(ctx, id)
+
+	domainAdmin, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if domainAdmin["username"] == nil {
+		return removeFromState(d, "domain admin")
+	}
+
+	if domainAdmin["active_int"] != nil {
+		domainAdmin["active"] = domainAdmin["active_int"]
+	}
+
+	exclude := []string{"password", "domains", "acl"}
+	err = setResourceData(resourceDomainAdmin(), d, &domainAdmin, &exclude, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domains := make([]string, 0)
+	if selectedDomains, ok := domainAdmin["selected_domains"].([]interface{}); ok {
+		for _, domain := range selectedDomains {
+			domains = append(domains, domain.(string))
+		}
+	}
+	err = d.Set("domains", domains)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceDomainAdminUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateDomainAdminRequest()
+
+	// mailcow replaces the domains and the username on every edit, so they always have to be sent
+	mailcowUpdateRequest.SetAttr("username_new", d.Get("username"))
+	mailcowUpdateRequest.SetAttr("domains", setToStringList(d.Get("domains").(*schema.Set)))
+	mailcowUpdateRequest.SetAttr("active", d.Get("active"))
+	if d.HasChange("password") {
+		mailcowUpdateRequest.SetAttr("password2", d.Get("password"))
+	}
+
+	exclude := []string{"username", "domains", "acl"}
+	err := mailcowUpdate(ctx, resourceDomainAdmin(), d, &exclude, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("acl") {
+		err = domainAdminUpdateAcl(ctx, d, c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDomainAdminRead(ctx, d, m)
+}
+
+func domainAdminUpdateAcl(ctx context.Context, d *schema.ResourceData, c *APIClient) error {
+	mailcowUpdateRequest := api.NewUpdateDomainAdminAclRequest()
+	mailcowUpdateRequest.SetAttr("da_acl", setToStringList(d.Get("acl").(*schema.Set)))
+	mailcowUpdateRequest.SetItem(d.Id())
+
+	response, err := api.MailcowUpdateExecute(ctx, c.client, mailcowUpdateRequest)
+	if err != nil {
+		return err
+	}
+	return checkResponse(response, mailcowUpdateRequest.ResourceName, d.Id())
+}
+
+func resourceDomainAdminDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteDomainAdminRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
@@ -0,0 +1,57 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDomainAdmin(t *testing.T) {
+	domain := fmt.Sprintf("with-domain-admin-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	username := fmt.Sprintf("withdomainadmin%s", randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDomainAdmin(domain, username, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_domain_admin.domain_admin", "id", username),
+					resource.TestCheckResourceAttr("mailcow_domain_admin.domain_admin", "domains.#", "1"),
+					resource.TestCheckResourceAttr("mailcow_domain_admin.domain_admin", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceDomainAdmin(domain, username, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_domain_admin.domain_admin", "active", "false"),
+				),
+			},
+			{
+				ResourceName:            "mailcow_domain_admin.domain_admin",
+				ImportState:             true,
+				ImportStateId:           username,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "acl"},
+			},
+		},
+	})
+}
+
+func testAccResourceDomainAdmin(domain string, username string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_domain_admin" "domain_admin" {
+  username = "%[2]s"
+  password = "Secret-Password-42"
+  domains  = [mailcow_domain.domain.domain]
+  active   = %[3]s
+  acl      = ["syncjobs", "filters"]
+}
+`, domain, username, active)
+}
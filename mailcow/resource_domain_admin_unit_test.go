@@ -0,0 +1,59 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceDomainAdminMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceDomainAdmin()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"username": "demoadmin",
+		"password": "secret-password",
+		"domains":  []interface{}{"440044.xyz"},
+		"acl":      []interface{}{"syncjobs", "filters"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":        "demoadmin",
+		"domains.#": "1",
+		"active":    "true",
+		"acl.#":     "2",
+	})
+	if acl := mock.get("domain-admin", "demoadmin")["da_acl"]; len(acl.([]interface{})) != 2 {
+		t.Errorf("da_acl not sent: %v", acl)
+	}
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"username":  "demoadmin",
+		"domains.#": "1",
+		"active":    "true",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"username": "demoadmin",
+		"password": "secret-password",
+		"domains":  []interface{}{"440044.xyz", "440045.xyz"},
+		"active":   false,
+		"acl":      []interface{}{"syncjobs"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"domains.#": "2",
+		"active":    "false",
+		"acl.#":     "1",
+	})
+
+	imported := testMockImport(t, res, "demoadmin", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"username":  "demoadmin",
+		"domains.#": "2",
+		"active":    "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("domain-admin", "demoadmin") != nil {
+		t.Fatal("domain admin not deleted")
+	}
+}
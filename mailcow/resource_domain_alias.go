@@ -2,8 +2,9 @@ package mailcow
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -44,8 +45,20 @@ func resourceDomainAlias() *schema.Resource {
 	}
 }
 
-func resourceDomainAliasImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	return []*schema.ResourceData{d}, nil
+// resourceDomainAliasImport imports a domain alias by the alias domain, which mailcow uses as id
+func resourceDomainAliasImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*APIClient)
+	aliasDomains, err := readAllRequest(c.client.Api.MailcowGetAliasDomains(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, aliasDomain := range aliasDomains {
+		if strings.EqualFold(fmt.Sprint(aliasDomain["alias_domain"]), d.Id()) {
+			d.SetId(fmt.Sprint(aliasDomain["alias_domain"]))
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+	return nil, fmt.Errorf("domain alias '%s' not found", d.Id())
 }
 
 func resourceDomainAliasCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -92,7 +105,7 @@ func resourceDomainAliasRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	if aliasDomain["alias_domain"] == nil {
-		return diag.FromErr(errors.New("domain alias not found: " + id))
+		return removeFromState(d, "domain alias")
 	}
 
 	err = setResourceData(resourceDomainAlias(), d, &aliasDomain, nil, nil)
@@ -0,0 +1,58 @@
+package mailcow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceDomainAliasMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceDomainAlias()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"alias_domain":  "alias.xyz",
+		"target_domain": "440044.xyz",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":     "alias.xyz",
+		"active": "true",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"alias_domain":  "alias.xyz",
+		"target_domain": "440044.xyz",
+		"active":        "true",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"alias_domain":  "alias.xyz",
+		"target_domain": "440044.xyz",
+		"active":        false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"active": "false",
+	})
+
+	for _, importId := range []string{"alias.xyz", "Alias.xyz"} {
+		imported := testMockImport(t, res, importId, meta)
+		testMockCheckAttrs(t, imported, map[string]string{
+			"id":            "alias.xyz",
+			"alias_domain":  "alias.xyz",
+			"target_domain": "440044.xyz",
+			"active":        "false",
+		})
+	}
+
+	_, err := res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "unknown.xyz"}), meta)
+	if err == nil {
+		t.Error("expected an error importing an unknown domain alias")
+	}
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("alias-domain", "alias.xyz") != nil {
+		t.Fatal("domain alias not deleted")
+	}
+}
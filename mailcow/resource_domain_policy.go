@@ -0,0 +1,26 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+var domainPolicyScope = policyScope{
+	argument:  "domain",
+	key:       "domain",
+	nameField: "domain_name",
+	getOwner: func(ctx context.Context, c *APIClient, domain string) api.ApiMailcowGetRequest {
+		return c.client.Api.MailcowGetDomain(ctx, domain)
+	},
+	getList: func(ctx context.Context, c *APIClient, list string, domain string) api.ApiMailcowGetAllRequest {
+		return c.client.Api.MailcowGetDomainPolicies(ctx, list, domain)
+	},
+	createReq: api.NewCreateDomainPolicyRequest,
+	deleteReq: api.NewDeleteDomainPolicyRequest,
+}
+
+func resourceDomainPolicy() *schema.Resource {
+	return policyResource(domainPolicyScope, "the domain the spam policy applies to")
+}
@@ -0,0 +1,57 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDomainPolicy(t *testing.T) {
+	domain := fmt.Sprintf("with-policy-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDomainPolicy(domain, `"*@partner.xyz", "*@friend.xyz"`, `"*@spam.xyz"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_domain_policy.policy", "domain", domain),
+					resource.TestCheckResourceAttr("mailcow_domain_policy.policy", "wl.#", "2"),
+					resource.TestCheckTypeSetElemAttr("mailcow_domain_policy.policy", "wl.*", "*@partner.xyz"),
+					resource.TestCheckTypeSetElemAttr("mailcow_domain_policy.policy", "wl.*", "*@friend.xyz"),
+					resource.TestCheckResourceAttr("mailcow_domain_policy.policy", "bl.#", "1"),
+					resource.TestCheckTypeSetElemAttr("mailcow_domain_policy.policy", "bl.*", "*@spam.xyz"),
+				),
+			},
+			{
+				Config: testAccResourceDomainPolicy(domain, `"*@partner.xyz"`, `"*@spam.xyz", "*@friend.xyz"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_domain_policy.policy", "wl.#", "1"),
+					resource.TestCheckResourceAttr("mailcow_domain_policy.policy", "bl.#", "2"),
+					resource.TestCheckTypeSetElemAttr("mailcow_domain_policy.policy", "bl.*", "*@friend.xyz"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_domain_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceDomainPolicy(domain string, wl string, bl string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_domain_policy" "policy" {
+  domain = mailcow_domain.domain.domain
+  wl     = [%[2]s]
+  bl     = [%[3]s]
+}
+`, domain, wl, bl)
+}
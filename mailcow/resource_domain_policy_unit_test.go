@@ -0,0 +1,88 @@
+package mailcow
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// mockPolicyValues returns the sorted "list:value" entries of the owner stored in the mock
+func mockPolicyValues(mock *mockMailcow, kind string, owner string) []string {
+	values := make([]string, 0)
+	for _, object := range mock.all(kind) {
+		if object["object"] == owner {
+			values = append(values, fmt.Sprint(object["object_list"], ":", object["value"]))
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+func TestResourceDomainPolicyMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceDomainPolicy()
+
+	testMockApply(t, resourceDomain(), nil, map[string]interface{}{
+		"domain": "440044.xyz",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"domain": "440044.xyz",
+		"wl":     []interface{}{"*@partner.xyz", "*@friend.xyz"},
+		"bl":     []interface{}{"*@spam.xyz"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":   "440044.xyz",
+		"wl.#": "2",
+		"bl.#": "1",
+	})
+	if values := mockPolicyValues(mock, "domain-policy", "440044.xyz"); !slices.Equal(values, []string{"bl:*@spam.xyz", "wl:*@friend.xyz", "wl:*@partner.xyz"}) {
+		t.Fatalf("unexpected policy entries %v", values)
+	}
+
+	// entries added outside of terraform are drift
+	mock.put("domain-policy", "99", map[string]interface{}{
+		"id":          99,
+		"prefid":      99,
+		"object":      "440044.xyz",
+		"object_list": policyListBlacklist,
+		"value":       "*@unmanaged.xyz",
+	})
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"bl.#": "2",
+	})
+
+	// *@friend.xyz moves to the blacklist, the unmanaged entry is removed
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"domain": "440044.xyz",
+		"wl":     []interface{}{"*@partner.xyz"},
+		"bl":     []interface{}{"*@spam.xyz", "*@friend.xyz"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"wl.#": "1",
+		"bl.#": "2",
+	})
+	if values := mockPolicyValues(mock, "domain-policy", "440044.xyz"); !slices.Equal(values, []string{"bl:*@friend.xyz", "bl:*@spam.xyz", "wl:*@partner.xyz"}) {
+		t.Fatalf("unexpected policy entries %v", values)
+	}
+
+	imported := testMockImport(t, res, "440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"domain": "440044.xyz",
+		"wl.#":   "1",
+		"bl.#":   "2",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if values := mockPolicyValues(mock, "domain-policy", "440044.xyz"); len(values) != 0 {
+		t.Fatalf("policy entries not deleted: %v", values)
+	}
+
+	mock.remove("domain", "440044.xyz")
+	state = testMockRefresh(t, res, state, meta)
+	if state != nil {
+		t.Fatalf("expected domain policy to be removed from state, got id %q", state.ID)
+	}
+}
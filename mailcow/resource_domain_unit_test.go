@@ -0,0 +1,61 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceDomainMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceDomain()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"domain": "440044.xyz",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":         "440044.xyz",
+		"aliases":    "400",
+		"rate_limit": "10s",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"domain":     "440044.xyz",
+		"aliases":    "400",
+		"mailboxes":  "10",
+		"defquota":   "3072",
+		"maxquota":   "10240",
+		"quota":      "10240",
+		"rate_limit": "10s",
+		"active":     "true",
+		"backupmx":   "false",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"domain":     "440044.xyz",
+		"aliases":    1000,
+		"quota":      42000,
+		"backupmx":   true,
+		"rate_limit": "20m",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"aliases":    "1000",
+		"quota":      "42000",
+		"backupmx":   "true",
+		"rate_limit": "20m",
+	})
+
+	imported := testMockImport(t, res, "440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"id":         "440044.xyz",
+		"domain":     "440044.xyz",
+		"aliases":    "1000",
+		"quota":      "42000",
+		"rate_limit": "20m",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("domain", "440044.xyz") != nil {
+		t.Fatal("domain not deleted")
+	}
+}
@@ -36,6 +36,7 @@ func resourceIdentityProviderKeycloak() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "the Client Secret assigned to the mailcow client in Keycloak",
 				Required:    true,
+				Sensitive:   true,
 				ForceNew:    true,
 			},
 			"import_users": {
@@ -129,6 +130,11 @@ func resourceIdentityProviderKeycloakRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// another identity provider replaced keycloak
+	if identityProviderKeycloak["authsource"] != mailcowAuthsourceKeycloak {
+		return removeFromState(d, "identity provider keycloak")
+	}
+
 	err = setResourceData(resourceIdentityProviderKeycloak(), d, &identityProviderKeycloak, nil, nil)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,49 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceIdentityProviderKeycloakMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceIdentityProviderKeycloak()
+
+	config := map[string]interface{}{
+		"server_url":    "https://auth.440044.xyz",
+		"realm":         "mailcow",
+		"client_id":     "mailcow_terraform",
+		"client_secret": "example",
+		"redirect_url":  "https://mail.440044.xyz",
+		"version":       "26.1.3",
+	}
+	state := testMockApply(t, res, nil, config, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":            "keycloak",
+		"authsource":    "keycloak",
+		"realm":         "mailcow",
+		"periodic_sync": "true",
+		"sync_interval": "15",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"server_url": "https://auth.440044.xyz",
+		"version":    "26.1.3",
+	})
+
+	// every argument forces a new identity provider configuration
+	config["realm"] = "other"
+	state = testMockApply(t, res, state, config, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"realm": "other",
+	})
+
+	imported := testMockImport(t, res, "keycloak", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"realm":     "other",
+		"client_id": "mailcow_terraform",
+	})
+
+	testMockDestroy(t, res, state, meta)
+}
@@ -2,6 +2,8 @@ package mailcow
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +18,80 @@ const (
 	mailcowAuthsourceOidc     = "generic-oidc"
 )
 
+// quarantineNotifications are the frequencies of quarantine notifications
+var quarantineNotifications = []string{
+	"never",
+	"hourly",
+	"daily",
+	"weekly",
+}
+
+// quarantineCategories are the categories of quarantined mails notified about
+var quarantineCategories = []string{
+	"reject",
+	"add_header",
+	"all",
+}
+
+// mailboxAttributes are the arguments mailcow returns in the nested attributes of a mailbox
+var mailboxAttributes = []string{
+	"force_pw_update",
+	"tls_enforce_in",
+	"tls_enforce_out",
+	"sogo_access",
+	"imap_access",
+	"pop3_access",
+	"smtp_access",
+	"sieve_access",
+	"eas_access",
+	"dav_access",
+	"relayhost",
+	"passwd_update",
+	"mailbox_format",
+	"quarantine_notification",
+	"quarantine_category",
+}
+
+// mailboxPasswordModes are the arguments setting the password of a mailbox, exactly one of them is required
+var mailboxPasswordModes = []string{
+	"password",
+	"password_wo",
+	"generate_password",
+}
+
+// mailboxPasswordArguments are the arguments of a mailbox mailcow does not return
+var mailboxPasswordArguments = []string{
+	"password",
+	"password_wo",
+	"password_version",
+	"generate_password",
+	"generated_password",
+}
+
+// passwordCharsets are the characters of generated passwords by option of generate_password
+var passwordCharsets = []struct {
+	option  string
+	charset string
+}{
+	{"lower", passwordCharsetLower},
+	{"upper", passwordCharsetUpper},
+	{"numeric", passwordCharsetNumeric},
+	{"special", passwordCharsetSpecial},
+}
+
+// mailboxComputedAccess are the protocol accesses which keep the value in mailcow if not configured
+var mailboxComputedAccess = []string{
+	"eas_access",
+	"dav_access",
+}
+
+// mailboxCollections are the arguments of a mailbox which are sets or maps and are set by setMailboxCollections
+var mailboxCollections = []string{
+	"tags",
+	"sender_acl",
+	"custom_attributes",
+}
+
 func resourceMailbox() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMailboxCreate,
@@ -23,6 +99,8 @@ func resourceMailbox() *schema.Resource {
 		UpdateContext: resourceMailboxUpdate,
 		DeleteContext: resourceMailboxDelete,
 
+		CustomizeDiff: resourceMailboxCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMailboxImport,
 		},
@@ -64,9 +142,70 @@ func resourceMailbox() *schema.Resource {
 				Required:    true,
 			},
 			"password": {
+				Type:         schema.TypeString,
+				Description:  "mailbox password, kept in the state",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: mailboxPasswordModes,
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Description:  "write-only mailbox password, never kept in the state, sent on creation and when password_version changes (requires terraform 1.11 or later)",
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"password_version"},
+			},
+			"password_version": {
+				Type:        schema.TypeInt,
+				Description: "version of the password, changing it sends password_wo again or generates a new password",
+				Optional:    true,
+			},
+			"generate_password": {
+				Type:        schema.TypeList,
+				Description: "generate a random password with this policy, returned in generated_password",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"length": {
+							Type:         schema.TypeInt,
+							Description:  "length of the password",
+							Default:      16,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(8),
+						},
+						"lower": {
+							Type:        schema.TypeBool,
+							Description: "if the password contains lower case letters",
+							Default:     true,
+							Optional:    true,
+						},
+						"upper": {
+							Type:        schema.TypeBool,
+							Description: "if the password contains upper case letters",
+							Default:     true,
+							Optional:    true,
+						},
+						"numeric": {
+							Type:        schema.TypeBool,
+							Description: "if the password contains digits",
+							Default:     true,
+							Optional:    true,
+						},
+						"special": {
+							Type:        schema.TypeBool,
+							Description: "if the password contains special characters",
+							Default:     false,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"generated_password": {
 				Type:        schema.TypeString,
-				Description: "mailbox password (the password is excluded from update)",
-				Required:    true,
+				Description: "the password generated by generate_password",
+				Computed:    true,
 				Sensitive:   true,
 			},
 			"quota": {
@@ -122,11 +261,61 @@ func resourceMailbox() *schema.Resource {
 				Default:     true,
 				Optional:    true,
 			},
-			//"relayhost": "0",
-			//"passwd_update": "2022-07-15 20:31:51",
-			//"mailbox_format": "maildir:",
-			//"quarantine_notification": "hourly",
-			//"quarantine_category": "reject"
+			"eas_access": {
+				Type:        schema.TypeBool,
+				Description: "if 'ActiveSync' is an allowed protocol, mailcow allows it by default",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dav_access": {
+				Type:        schema.TypeBool,
+				Description: "if 'CalDAV' and 'CardDAV' are allowed protocols, mailcow allows them by default",
+				Optional:    true,
+				Computed:    true,
+			},
+			"relayhost": relayhostSchema(),
+			"quarantine_notification": {
+				Type:         schema.TypeString,
+				Description:  "frequency of quarantine notifications. One of: never, hourly, daily, weekly.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(quarantineNotifications, false),
+			},
+			"quarantine_category": {
+				Type:         schema.TypeString,
+				Description:  "category of quarantined mails notified about, rejected mails (reject), mails sent to the junk folder (add_header) or both (all). One of: reject, add_header, all.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(quarantineCategories, false),
+			},
+			"sender_acl": {
+				Type:        schema.TypeSet,
+				Description: "addresses the mailbox is allowed to send as, \"@domain\" for all addresses of a domain, \"*\" for any address (mailcow does not return them, changes outside of terraform are not detected)",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Description: "tags of the mailbox",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_attributes": {
+				Type:        schema.TypeMap,
+				Description: "custom attributes of the mailbox",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"passwd_update": {
+				Type:        schema.TypeString,
+				Description: "time of the last password update",
+				Computed:    true,
+			},
+			"mailbox_format": {
+				Type:        schema.TypeString,
+				Description: "mailbox format of dovecot, e.g. \"maildir:\"",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -136,8 +325,6 @@ func resourceMailboxImport(_ context.Context, d *schema.ResourceData, _ interfac
 }
 
 func resourceMailboxCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
 	c := m.(*APIClient)
 
 	mailcowCreateRequest := api.NewCreateMailboxRequest()
@@ -148,18 +335,59 @@ func resourceMailboxCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	mailcowCreateRequest.Set("password2", d.Get("password"))
+	password, err := mailboxPassword(d, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mailcowCreateRequest.Set("password", password)
+	mailcowCreateRequest.Set("password2", password)
 
 	mapArguments := map[string]string{"full_name": "name"}
 
-	err = mailcowCreate(ctx, resourceMailbox(), d, address, nil, &mapArguments, mailcowCreateRequest, c)
+	mailcowCreateRequest.Set("tags", setToStringList(d.Get("tags").(*schema.Set)))
+
+	for _, argument := range mailboxComputedAccess {
+		// GetOkExists distinguishes false from not configured, mailcow uses its default then
+		if value, ok := d.GetOkExists(argument); ok {
+			mailcowCreateRequest.Set(argument, value)
+		}
+	}
+
+	exclude := append([]string{
+		"relayhost",
+		"quarantine_notification",
+		"quarantine_category",
+		"sender_acl",
+		"tags",
+		"custom_attributes",
+		"passwd_update",
+		"mailbox_format",
+		"eas_access",
+		"dav_access",
+	}, mailboxPasswordArguments...)
+	err = mailcowCreate(ctx, resourceMailbox(), d, address, &exclude, &mapArguments, mailcowCreateRequest, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(address)
 
-	return diags
+	err = setGeneratedPassword(d, password)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateRelayhost(ctx, d, api.NewUpdateMailboxRequest(), c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateMailboxSettings(ctx, d, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMailboxRead(ctx, d, m)
 }
 
 func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -175,19 +403,12 @@ func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	exclude := []string{
-		"password",
-	}
-	mailboxAttributes := []string{
-		"force_pw_update",
-		"tls_enforce_in",
-		"tls_enforce_out",
-		"sogo_access",
-		"imap_access",
-		"pop3_access",
-		"smtp_access",
-		"sieve_access",
+	if mailbox["username"] == nil {
+		return removeFromState(d, "mailbox")
 	}
+
+	// a fresh slice, appending to the package-level list could corrupt concurrent reads
+	exclude := append([]string{}, mailboxPasswordArguments...)
 	mailbox["address"] = id
 	mailbox["full_name"] = mailbox["name"]
 	if mailbox["quota"] != nil {
@@ -196,7 +417,7 @@ func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interfac
 		mailbox["quota"] = 0
 	}
 
-	excludeAndAttributes := append(exclude, mailboxAttributes...)
+	excludeAndAttributes := append(append(append([]string{}, exclude...), mailboxAttributes...), mailboxCollections...)
 	err = setResourceData(resourceMailbox(), d, &mailbox, &excludeAndAttributes, nil)
 	if err != nil {
 		return diag.FromErr(err)
@@ -208,6 +429,10 @@ func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diag.FromErr(err)
 		}
 	}
+	err = setMailboxCollections(d, mailbox)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(id)
 
@@ -219,13 +444,51 @@ func resourceMailboxUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	mailcowUpdateRequest := api.NewUpdateMailboxRequest()
 
-	exclude := []string{
-		"password",
+	password := ""
+	if d.HasChanges(mailboxPasswordArguments...) {
+		var err error
+		password, err = mailboxPassword(d, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if password != "" {
+			mailcowUpdateRequest.SetAttr("password", password)
+			mailcowUpdateRequest.SetAttr("password2", password)
+		}
 	}
+
+	exclude := append([]string{
+		"quarantine_notification",
+		"quarantine_category",
+		"sender_acl",
+		"tags",
+		"custom_attributes",
+		"passwd_update",
+		"mailbox_format",
+	}, mailboxPasswordArguments...)
 	mapArguments := map[string]string{
 		"full_name": "name",
 	}
 	err := mailcowUpdate(ctx, resourceMailbox(), d, &exclude, &mapArguments, mailcowUpdateRequest, c)
+	if err != nil {
+		// keep the previous state, so that the next plan sends the password again
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(mailboxPasswordArguments...) {
+		err = setGeneratedPassword(d, password)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = updateMailboxTags(ctx, d, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateMailboxSettings(ctx, d, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -233,6 +496,197 @@ func resourceMailboxUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	return resourceMailboxRead(ctx, d, m)
 }
 
+// resourceMailboxCustomizeDiff plans a new generated password if the policy or the password version changes
+func resourceMailboxCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("generate_password") || (len(d.Get("generate_password").([]interface{})) > 0 && d.HasChange("password_version")) {
+		return d.SetNewComputed("generated_password")
+	}
+	return nil
+}
+
+// mailboxPassword returns the password to send to mailcow, "" if it does not change
+func mailboxPassword(d *schema.ResourceData, create bool) (string, error) {
+	if policies := d.Get("generate_password").([]interface{}); len(policies) > 0 && policies[0] != nil {
+		if !create && !d.HasChanges("generate_password", "password_version") {
+			return "", nil
+		}
+		return generateMailboxPassword(policies[0].(map[string]interface{}))
+	}
+
+	if passwordWo := mailboxPasswordWo(d); passwordWo != "" {
+		if !create && !d.HasChange("password_version") {
+			return "", nil
+		}
+		return passwordWo, nil
+	}
+	if !create && !d.HasChange("password") {
+		return "", nil
+	}
+	return d.Get("password").(string), nil
+}
+
+// setGeneratedPassword keeps the password sent to mailcow if it was generated, it is called after mailcow accepted it
+func setGeneratedPassword(d *schema.ResourceData, password string) error {
+	if policies := d.Get("generate_password").([]interface{}); len(policies) > 0 && policies[0] != nil {
+		if password == "" {
+			return nil
+		}
+		return d.Set("generated_password", password)
+	}
+	return d.Set("generated_password", "")
+}
+
+// mailboxPasswordWo returns the write-only password, which terraform only sends in the raw configuration
+func mailboxPasswordWo(d *schema.ResourceData) string {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return ""
+	}
+	passwordWo := rawConfig.GetAttr("password_wo")
+	if passwordWo.IsNull() || !passwordWo.IsKnown() {
+		return ""
+	}
+	return passwordWo.AsString()
+}
+
+func generateMailboxPassword(policy map[string]interface{}) (string, error) {
+	charsets := make([]string, 0, len(passwordCharsets))
+	for _, passwordCharset := range passwordCharsets {
+		if policy[passwordCharset.option].(bool) {
+			charsets = append(charsets, passwordCharset.charset)
+		}
+	}
+	if len(charsets) == 0 {
+		return "", fmt.Errorf("generate_password: at least one of lower, upper, numeric or special has to be true")
+	}
+	return generatePassword(policy["length"].(int), charsets)
+}
+
+// setMailboxCollections sets the tags and the custom attributes returned by mailcow, mailcow does not return the sender ACL
+func setMailboxCollections(d *schema.ResourceData, mailbox map[string]interface{}) error {
+	err := d.Set("tags", mailboxTags(mailbox))
+	if err != nil {
+		return err
+	}
+	return d.Set("custom_attributes", mailboxCustomAttributes(mailbox))
+}
+
+func mailboxTags(mailbox map[string]interface{}) []string {
+	tags := make([]string, 0)
+	if mailboxTags, ok := mailbox["tags"].([]interface{}); ok {
+		for _, tag := range mailboxTags {
+			tags = append(tags, fmt.Sprint(tag))
+		}
+	}
+	return tags
+}
+
+func mailboxCustomAttributes(mailbox map[string]interface{}) map[string]string {
+	// mailcow returns an empty array instead of an empty object if there are no custom attributes
+	customAttributes := make(map[string]string)
+	if mailboxCustomAttributes, ok := mailbox["custom_attributes"].(map[string]interface{}); ok {
+		for attribute, value := range mailboxCustomAttributes {
+			customAttributes[attribute] = fmt.Sprint(value)
+		}
+	}
+	return customAttributes
+}
+
+// updateMailboxSettings sends the changed arguments mailcow edits by their own requests
+func updateMailboxSettings(ctx context.Context, d *schema.ResourceData, c *APIClient) error {
+	if d.HasChange("quarantine_notification") && d.Get("quarantine_notification").(string) != "" {
+		mailcowUpdateRequest := api.NewUpdateQuarantineNotificationRequest()
+		mailcowUpdateRequest.SetAttr("quarantine_notification", d.Get("quarantine_notification"))
+		err := updateMailboxRequest(ctx, d, mailcowUpdateRequest, c)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("quarantine_category") && d.Get("quarantine_category").(string) != "" {
+		mailcowUpdateRequest := api.NewUpdateQuarantineCategoryRequest()
+		mailcowUpdateRequest.SetAttr("quarantine_category", d.Get("quarantine_category"))
+		err := updateMailboxRequest(ctx, d, mailcowUpdateRequest, c)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("sender_acl") {
+		// mailcow replaces the sender ACL on every edit
+		mailcowUpdateRequest := api.NewUpdateMailboxRequest()
+		mailcowUpdateRequest.SetAttr("sender_acl", setToStringList(d.Get("sender_acl").(*schema.Set)))
+		err := updateMailboxRequest(ctx, d, mailcowUpdateRequest, c)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("custom_attributes") {
+		// mailcow replaces the custom attributes on every edit
+		customAttributes := d.Get("custom_attributes").(map[string]interface{})
+		attributes := make([]string, 0, len(customAttributes))
+		for attribute := range customAttributes {
+			attributes = append(attributes, attribute)
+		}
+		sort.Strings(attributes)
+		values := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			values[i] = customAttributes[attribute].(string)
+		}
+		mailcowUpdateRequest := api.NewUpdateMailboxCustomAttributeRequest()
+		mailcowUpdateRequest.SetAttr("attribute", attributes)
+		mailcowUpdateRequest.SetAttr("value", values)
+		err := updateMailboxRequest(ctx, d, mailcowUpdateRequest, c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateMailboxTags adds the new tags and deletes the removed tags, mailcow only adds tags on edit
+func updateMailboxTags(ctx context.Context, d *schema.ResourceData, c *APIClient) error {
+	if !d.HasChange("tags") {
+		return nil
+	}
+	oldTags, newTags := d.GetChange("tags")
+	added := newTags.(*schema.Set).Difference(oldTags.(*schema.Set))
+	removed := oldTags.(*schema.Set).Difference(newTags.(*schema.Set))
+
+	if added.Len() > 0 {
+		mailcowUpdateRequest := api.NewUpdateMailboxRequest()
+		mailcowUpdateRequest.SetAttr("tags", setToStringList(added))
+		err := updateMailboxRequest(ctx, d, mailcowUpdateRequest, c)
+		if err != nil {
+			return err
+		}
+	}
+	for _, tag := range setToStringList(removed) {
+		mailcowDeleteRequest := api.NewDeleteMailboxTagRequest(d.Id())
+		mailcowDeleteRequest.SetItem(tag)
+		response, err := api.MailcowDeleteExecute(ctx, c.client, mailcowDeleteRequest)
+		if err != nil {
+			return err
+		}
+		err = checkResponse(response, mailcowDeleteRequest.ResourceName, d.Id()+" tag "+tag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateMailboxRequest(ctx context.Context, d *schema.ResourceData, mailcowUpdateRequest *api.MailcowUpdateRequest, c *APIClient) error {
+	mailcowUpdateRequest.SetItem(d.Id())
+
+	response, err := api.MailcowUpdateExecute(ctx, c.client, mailcowUpdateRequest)
+	if err != nil {
+		return err
+	}
+	return checkResponse(response, mailcowUpdateRequest.ResourceName, d.Id())
+}
+
 func resourceMailboxDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*APIClient)
 	mailcowDeleteRequest := api.NewDeleteMailboxRequest()
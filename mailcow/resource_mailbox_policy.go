@@ -0,0 +1,26 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+var mailboxPolicyScope = policyScope{
+	argument:  "mailbox",
+	key:       "username",
+	nameField: "username",
+	getOwner: func(ctx context.Context, c *APIClient, mailbox string) api.ApiMailcowGetRequest {
+		return c.client.Api.MailcowGetMailbox(ctx, mailbox)
+	},
+	getList: func(ctx context.Context, c *APIClient, list string, mailbox string) api.ApiMailcowGetAllRequest {
+		return c.client.Api.MailcowGetMailboxPolicies(ctx, list, mailbox)
+	},
+	createReq: api.NewCreateMailboxPolicyRequest,
+	deleteReq: api.NewDeleteMailboxPolicyRequest,
+}
+
+func resourceMailboxPolicy() *schema.Resource {
+	return policyResource(mailboxPolicyScope, "e-mail address of the mailbox the spam policy applies to")
+}
@@ -0,0 +1,62 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceMailboxPolicy(t *testing.T) {
+	domain := fmt.Sprintf("with-mailbox-policy-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMailboxPolicy(domain, `"*@partner.xyz"`, `"*@spam.xyz", "*@scam.xyz"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_mailbox_policy.policy", "mailbox", "demo@"+domain),
+					resource.TestCheckResourceAttr("mailcow_mailbox_policy.policy", "wl.#", "1"),
+					resource.TestCheckTypeSetElemAttr("mailcow_mailbox_policy.policy", "wl.*", "*@partner.xyz"),
+					resource.TestCheckResourceAttr("mailcow_mailbox_policy.policy", "bl.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceMailboxPolicy(domain, `"*@partner.xyz", "*@friend.xyz"`, `"*@spam.xyz"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_mailbox_policy.policy", "wl.#", "2"),
+					resource.TestCheckTypeSetElemAttr("mailcow_mailbox_policy.policy", "wl.*", "*@friend.xyz"),
+					resource.TestCheckResourceAttr("mailcow_mailbox_policy.policy", "bl.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_mailbox_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMailboxPolicy(domain string, wl string, bl string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  domain     = mailcow_domain.domain.domain
+  local_part = "demo"
+  full_name  = "Demo User"
+  password   = "secret-password"
+}
+
+resource "mailcow_mailbox_policy" "policy" {
+  mailbox = mailcow_mailbox.mailbox.address
+  wl      = [%[2]s]
+  bl      = [%[3]s]
+}
+`, domain, wl, bl)
+}
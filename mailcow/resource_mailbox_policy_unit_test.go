@@ -0,0 +1,75 @@
+package mailcow
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResourceMailboxPolicyMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceMailboxPolicy()
+
+	testMockApply(t, resourceMailbox(), nil, map[string]interface{}{
+		"domain":     "440044.xyz",
+		"local_part": "demo",
+		"full_name":  "Demo User",
+		"password":   "secret-password",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"mailbox": "demo@440044.xyz",
+		"wl":      []interface{}{"*@partner.xyz"},
+		"bl":      []interface{}{"*@spam.xyz", "*@scam.xyz"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":   "demo@440044.xyz",
+		"wl.#": "1",
+		"bl.#": "2",
+	})
+	if values := mockPolicyValues(mock, "mailbox-policy", "demo@440044.xyz"); !slices.Equal(values, []string{"bl:*@scam.xyz", "bl:*@spam.xyz", "wl:*@partner.xyz"}) {
+		t.Fatalf("unexpected policy entries %v", values)
+	}
+	prefids := make(map[string]interface{})
+	for _, object := range mock.all("mailbox-policy") {
+		prefids[object["value"].(string)] = object["prefid"]
+	}
+
+	// only the changed entries are deleted and added
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"mailbox": "demo@440044.xyz",
+		"wl":      []interface{}{"*@partner.xyz", "*@friend.xyz"},
+		"bl":      []interface{}{"*@spam.xyz"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"wl.#": "2",
+		"bl.#": "1",
+	})
+	if values := mockPolicyValues(mock, "mailbox-policy", "demo@440044.xyz"); !slices.Equal(values, []string{"bl:*@spam.xyz", "wl:*@friend.xyz", "wl:*@partner.xyz"}) {
+		t.Fatalf("unexpected policy entries %v", values)
+	}
+	for _, object := range mock.all("mailbox-policy") {
+		value := object["value"].(string)
+		if prefid, ok := prefids[value]; ok && prefid != object["prefid"] {
+			t.Errorf("unchanged entry %s was re-created", value)
+		}
+	}
+
+	imported := testMockImport(t, res, "demo@440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"mailbox": "demo@440044.xyz",
+		"wl.#":    "2",
+		"bl.#":    "1",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if values := mockPolicyValues(mock, "mailbox-policy", "demo@440044.xyz"); len(values) != 0 {
+		t.Fatalf("policy entries not deleted: %v", values)
+	}
+
+	mock.remove("mailbox", "demo@440044.xyz")
+	state = testMockRefresh(t, res, state, meta)
+	if state != nil {
+		t.Fatalf("expected mailbox policy to be removed from state, got id %q", state.ID)
+	}
+}
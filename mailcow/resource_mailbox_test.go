@@ -160,3 +160,48 @@ resource "mailcow_mailbox" "mailbox-create" {
 }
 `, domain)
 }
+
+func TestAccResourceMailboxGeneratePassword(t *testing.T) {
+	domain := fmt.Sprintf("with-mailbox-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	localPart := fmt.Sprintf("with-mailbox-%s", randomLowerCaseString(4))
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMailboxGeneratePassword(domain, localPart, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_mailbox.mailbox", "password", ""),
+					resource.TestMatchResourceAttr("mailcow_mailbox.mailbox", "generated_password", regexp.MustCompile("^.{20}$")),
+				),
+			},
+			{
+				Config: testAccResourceMailboxGeneratePassword(domain, localPart, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_mailbox.mailbox", "password_version", "2"),
+					resource.TestMatchResourceAttr("mailcow_mailbox.mailbox", "generated_password", regexp.MustCompile("^.{20}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceMailboxGeneratePassword(domain string, localPart string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  local_part       = "%[2]s"
+  domain           = mailcow_domain.domain.id
+  full_name        = "%[2]s"
+  password_version = %[3]d
+
+  generate_password {
+    length  = 20
+    special = true
+  }
+}
+`, domain, localPart, passwordVersion)
+}
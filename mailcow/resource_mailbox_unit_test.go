@@ -1,7 +1,12 @@
 package mailcow
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // TestMailboxQuotaNilHandling tests that nil quota values are handled correctly
@@ -172,3 +177,384 @@ func TestMailboxDataIntegrity(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceMailboxMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceMailbox()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"domain":     "440044.xyz",
+		"local_part": "demo",
+		"full_name":  "Demo User",
+		"password":   "secret-password",
+		"quota":      2048,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":      "demo@440044.xyz",
+		"address": "demo@440044.xyz",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"full_name":       "Demo User",
+		"quota":           "2048",
+		"authsource":      "mailcow",
+		"imap_access":     "true",
+		"tls_enforce_in":  "false",
+		"force_pw_update": "true",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"domain":         "440044.xyz",
+		"local_part":     "demo",
+		"full_name":      "Updated User",
+		"password":       "secret-password",
+		"quota":          4096,
+		"imap_access":    false,
+		"tls_enforce_in": true,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"full_name":      "Updated User",
+		"quota":          "4096",
+		"imap_access":    "false",
+		"tls_enforce_in": "true",
+	})
+
+	imported := testMockImport(t, res, "demo@440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"domain":      "440044.xyz",
+		"local_part":  "demo",
+		"full_name":   "Updated User",
+		"quota":       "4096",
+		"imap_access": "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("mailbox", "demo@440044.xyz") != nil {
+		t.Fatal("mailbox not deleted")
+	}
+}
+
+func TestResourceMailboxAttributesMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceMailbox()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"domain":                  "440044.xyz",
+		"local_part":              "demo",
+		"full_name":               "Demo User",
+		"password":                "secret-password",
+		"eas_access":              false,
+		"quarantine_notification": "daily",
+		"sender_acl":              []interface{}{"@440044.xyz"},
+		"tags":                    []interface{}{"staff", "sales"},
+		"custom_attributes":       map[string]interface{}{"department": "sales"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"eas_access":                   "false",
+		"dav_access":                   "true",
+		"quarantine_notification":      "daily",
+		"quarantine_category":          "reject",
+		"mailbox_format":               "maildir:",
+		"tags.#":                       "2",
+		"sender_acl.#":                 "1",
+		"custom_attributes.department": "sales",
+	})
+	if state.Attributes["passwd_update"] == "" {
+		t.Fatal("passwd_update not set")
+	}
+	if acl := mock.get("mailbox", "demo@440044.xyz")["sender_acl"]; fmt.Sprint(acl) != "[@440044.xyz]" {
+		t.Fatalf("sender_acl = %v", acl)
+	}
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"domain":              "440044.xyz",
+		"local_part":          "demo",
+		"full_name":           "Demo User",
+		"password":            "secret-password",
+		"eas_access":          true,
+		"quarantine_category": "all",
+		"tags":                []interface{}{"staff", "support"},
+		"custom_attributes":   map[string]interface{}{"room": "42"},
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"eas_access":              "true",
+		"quarantine_notification": "daily",
+		"quarantine_category":     "all",
+		"tags.#":                  "2",
+		"sender_acl.#":            "0",
+		"custom_attributes.%":     "1",
+		"custom_attributes.room":  "42",
+	})
+	mailbox := mock.get("mailbox", "demo@440044.xyz")
+	if tags := fmt.Sprint(mailbox["tags"]); tags != "[staff support]" {
+		t.Fatalf("tags = %s", tags)
+	}
+	if acl := fmt.Sprint(mailbox["sender_acl"]); acl != "[]" {
+		t.Fatalf("sender_acl = %s", acl)
+	}
+
+	imported := testMockImport(t, res, "demo@440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"quarantine_category":    "all",
+		"tags.#":                 "2",
+		"custom_attributes.room": "42",
+	})
+}
+
+func TestResourceMailboxAccessMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceMailbox()
+	config := map[string]interface{}{
+		"domain":     "440044.xyz",
+		"local_part": "demo",
+		"full_name":  "Demo User",
+		"password":   "secret-password",
+	}
+
+	state := testMockApply(t, res, nil, config, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"eas_access": "true",
+		"dav_access": "true",
+	})
+
+	// an admin turned off ActiveSync in the UI, an unconfigured eas_access keeps it off
+	mockApplyMailboxAttributes(mock.get("mailbox", "demo@440044.xyz"), map[string]interface{}{"eas_access": "0"})
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"eas_access": "false",
+	})
+	diff, err := res.Diff(t.Context(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	if diff != nil && diff.Attributes["eas_access"] != nil {
+		t.Fatalf("eas_access planned: %v", diff.Attributes["eas_access"])
+	}
+}
+
+func TestResourceMailboxValidation(t *testing.T) {
+	res := resourceMailbox()
+	for _, tc := range []struct {
+		name  string
+		key   string
+		value string
+		valid bool
+	}{
+		{"notification hourly", "quarantine_notification", "hourly", true},
+		{"notification never", "quarantine_notification", "never", true},
+		{"notification monthly", "quarantine_notification", "monthly", false},
+		{"category add_header", "quarantine_category", "add_header", true},
+		{"category junk", "quarantine_category", "junk", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"domain":     "440044.xyz",
+				"local_part": "demo",
+				"full_name":  "Demo User",
+				"password":   "secret-password",
+				tc.key:       tc.value,
+			}))
+			if diags.HasError() == tc.valid {
+				t.Fatalf("valid = %v, diagnostics: %v", tc.valid, diags)
+			}
+		})
+	}
+}
+
+func TestResourceMailboxPasswordMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceMailbox()
+
+	sent := func(password string) bool {
+		return mock.get("mailbox", "demo@440044.xyz")["mock_password"] == mockPasswordHash(password)
+	}
+	config := func(arguments map[string]interface{}) map[string]interface{} {
+		raw := map[string]interface{}{
+			"domain":     "440044.xyz",
+			"local_part": "demo",
+			"full_name":  "Demo User",
+		}
+		for key, value := range arguments {
+			raw[key] = value
+		}
+		return raw
+	}
+
+	state := testMockApply(t, res, nil, config(map[string]interface{}{"password": "secret-1"}), meta)
+	if !sent("secret-1") {
+		t.Fatal("password not sent")
+	}
+
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password": "secret-2"}), meta)
+	if !sent("secret-2") {
+		t.Fatal("password not updated")
+	}
+
+	state = testMockApplyWriteOnly(t, res, state, config(map[string]interface{}{"password_version": 1}), map[string]string{"password_wo": "secret-3"}, meta)
+	if !sent("secret-3") {
+		t.Fatal("write-only password not sent")
+	}
+	testMockCheckAttrs(t, state, map[string]string{
+		"password":         "",
+		"password_wo":      "",
+		"password_version": "1",
+	})
+
+	state = testMockApplyWriteOnly(t, res, state, config(map[string]interface{}{"password_version": 1, "quota": 1024}), map[string]string{"password_wo": "secret-4"}, meta)
+	if !sent("secret-3") {
+		t.Fatal("write-only password sent without a new version")
+	}
+
+	state = testMockApplyWriteOnly(t, res, state, config(map[string]interface{}{"password_version": 2, "quota": 1024}), map[string]string{"password_wo": "secret-4"}, meta)
+	if !sent("secret-4") {
+		t.Fatal("write-only password not rotated")
+	}
+
+	generate := []interface{}{map[string]interface{}{"length": 24, "special": true}}
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password_version": 2, "quota": 1024, "generate_password": generate}), meta)
+	generated := state.Attributes["generated_password"]
+	if len(generated) != 24 || !sent(generated) {
+		t.Fatalf("generated password of length %d not sent", len(generated))
+	}
+
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password_version": 2, "quota": 2048, "generate_password": generate}), meta)
+	if state.Attributes["generated_password"] != generated {
+		t.Fatal("generated password changed without a new version")
+	}
+
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password_version": 3, "quota": 2048, "generate_password": generate}), meta)
+	if state.Attributes["generated_password"] == generated || !sent(state.Attributes["generated_password"]) {
+		t.Fatal("generated password not rotated")
+	}
+
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password": "secret-5", "quota": 2048}), meta)
+	if !sent("secret-5") || state.Attributes["generated_password"] != "" {
+		t.Fatal("password not sent or generated password kept")
+	}
+}
+
+func TestResourceMailboxPasswordFailedEditMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceMailbox()
+
+	sent := func(password string) bool {
+		return mock.get("mailbox", "demo@440044.xyz")["mock_password"] == mockPasswordHash(password)
+	}
+	config := func(arguments map[string]interface{}) map[string]interface{} {
+		raw := map[string]interface{}{
+			"domain":     "440044.xyz",
+			"local_part": "demo",
+			"full_name":  "Demo User",
+		}
+		for key, value := range arguments {
+			raw[key] = value
+		}
+		return raw
+	}
+	// applyFailing applies the configuration while mailcow rejects the edit and returns the state terraform keeps
+	applyFailing := func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+		t.Helper()
+		diff, err := res.Diff(t.Context(), state, terraform.NewResourceConfigRaw(raw), meta)
+		if err != nil {
+			t.Fatalf("diff: %s", err)
+		}
+		mock.fail(mockMailcowFailure{statusCode: http.StatusBadRequest})
+		newState, diags := res.Apply(t.Context(), state, diff, meta)
+		if !diags.HasError() {
+			t.Fatal("apply: expected an error")
+		}
+		return newState
+	}
+
+	state := testMockApply(t, res, nil, config(map[string]interface{}{"password": "secret-1"}), meta)
+	state = applyFailing(state, config(map[string]interface{}{"password": "secret-2"}))
+	testMockCheckAttrs(t, state, map[string]string{"password": "secret-1"})
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password": "secret-2"}), meta)
+	if !sent("secret-2") {
+		t.Fatal("password not sent after a failed edit")
+	}
+
+	generate := []interface{}{map[string]interface{}{"length": 16}}
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password_version": 1, "generate_password": generate}), meta)
+	generated := state.Attributes["generated_password"]
+	state = applyFailing(state, config(map[string]interface{}{"password_version": 2, "generate_password": generate}))
+	testMockCheckAttrs(t, state, map[string]string{
+		"password_version":   "1",
+		"generated_password": generated,
+	})
+	if !sent(generated) {
+		t.Fatal("generated password changed in mailcow by a failed edit")
+	}
+	state = testMockApply(t, res, state, config(map[string]interface{}{"password_version": 2, "generate_password": generate}), meta)
+	if state.Attributes["generated_password"] == generated || !sent(state.Attributes["generated_password"]) {
+		t.Fatal("generated password not sent after a failed edit")
+	}
+}
+
+func TestResourceMailboxPasswordValidation(t *testing.T) {
+	res := resourceMailbox()
+	for _, tc := range []struct {
+		name      string
+		arguments map[string]interface{}
+		valid     bool
+	}{
+		{"password", map[string]interface{}{"password": "secret"}, true},
+		{"generated", map[string]interface{}{"generate_password": []interface{}{map[string]interface{}{}}}, true},
+		{"none", map[string]interface{}{}, false},
+		{"password and generated", map[string]interface{}{"password": "secret", "generate_password": []interface{}{map[string]interface{}{}}}, false},
+		{"short generated", map[string]interface{}{"generate_password": []interface{}{map[string]interface{}{"length": 4}}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"domain":     "440044.xyz",
+				"local_part": "demo",
+				"full_name":  "Demo User",
+			}
+			for key, value := range tc.arguments {
+				raw[key] = value
+			}
+			diags := res.Validate(terraform.NewResourceConfigRaw(raw))
+			if diags.HasError() == tc.valid {
+				t.Fatalf("valid = %v, diagnostics: %v", tc.valid, diags)
+			}
+		})
+	}
+}
+
+func TestGenerateMailboxPassword(t *testing.T) {
+	password, err := generateMailboxPassword(map[string]interface{}{
+		"length":  12,
+		"lower":   false,
+		"upper":   true,
+		"numeric": true,
+		"special": true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^[A-Z0-9!#$%&*+\-.:=?@_]{12}$`).MatchString(password) {
+		t.Fatalf("unexpected password %q", password)
+	}
+	for _, class := range []string{`[A-Z]`, `[0-9]`, `[!#$%&*+\-.:=?@_]`} {
+		if !regexp.MustCompile(class).MatchString(password) {
+			t.Errorf("password %q has no character of %s", password, class)
+		}
+	}
+
+	_, err = generateMailboxPassword(map[string]interface{}{
+		"length":  12,
+		"lower":   false,
+		"upper":   false,
+		"numeric": false,
+		"special": false,
+	})
+	if err == nil {
+		t.Fatal("expected an error without character classes")
+	}
+}
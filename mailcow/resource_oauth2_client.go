@@ -106,6 +106,10 @@ func resourceOAuth2ClientRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	if oAuth2Client["client_id"] == nil {
+		return removeFromState(d, "oauth2 client")
+	}
+
 	err = setResourceData(resourceOAuth2Client(), d, &oAuth2Client, nil, nil)
 	if err != nil {
 		return diag.FromErr(err)
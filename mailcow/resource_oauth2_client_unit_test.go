@@ -0,0 +1,54 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceOAuth2ClientMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceOAuth2Client()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"redirect_uri": "https://redirect.uri",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":            "1",
+		"client_id":     "client-1",
+		"client_secret": "secret-1",
+		"scope":         "profile",
+	})
+
+	testMockApplyError(t, resourceOAuth2Client(), nil, map[string]interface{}{
+		"redirect_uri": "https://redirect.uri",
+	}, meta)
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"redirect_uri": "https://redirect.uri",
+		"client_id":    "client-1",
+	})
+
+	// the redirect uri forces a new client
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"redirect_uri": "https://other.uri",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":           "2",
+		"redirect_uri": "https://other.uri",
+	})
+	if mock.get("oauth2-client", "1") != nil {
+		t.Error("replaced client not deleted")
+	}
+
+	imported := testMockImport(t, res, "2", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"redirect_uri": "https://other.uri",
+		"client_id":    "client-2",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("oauth2-client", "2") != nil {
+		t.Fatal("oauth2 client not deleted")
+	}
+}
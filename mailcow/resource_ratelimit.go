@@ -0,0 +1,153 @@
+package mailcow
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+func resourceRateLimit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRateLimitCreate,
+		ReadContext:   resourceRateLimitRead,
+		UpdateContext: resourceRateLimitUpdate,
+		DeleteContext: resourceRateLimitDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRateLimitImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mailbox": {
+				Type:         schema.TypeString,
+				Description:  "e-mail address of the mailbox the rate limit applies to",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"mailbox", "domain"},
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Description:  "domain the rate limit applies to, conflicts with rate_limit of mailcow_domain",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"mailbox", "domain"},
+			},
+			"rate_limit": {
+				Type:             schema.TypeString,
+				Description:      "rate limit, decimal with unit s,m,h,d",
+				Required:         true,
+				ValidateDiagFunc: validateRateLimitDiag,
+			},
+		},
+	}
+}
+
+// resourceRateLimitImport accepts the e-mail address of a mailbox or a domain
+func resourceRateLimitImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	target := "domain"
+	if strings.Contains(d.Id(), "@") {
+		target = "mailbox"
+	}
+	err := d.Set(target, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// rateLimitRequests returns the requests reading and editing the rate limit of the mailbox or domain
+func rateLimitRequests(ctx context.Context, d *schema.ResourceData, c *APIClient) (api.ApiMailcowGetRequest, *api.MailcowUpdateRequest) {
+	if d.Get("mailbox").(string) != "" {
+		return c.client.Api.MailcowGetRateLimitMailbox(ctx, d.Id()), api.NewUpdateRateLimitMailboxRequest()
+	}
+	return c.client.Api.MailcowGetRateLimitDomain(ctx, d.Id()), api.NewUpdateRateLimitDomainRequest()
+}
+
+func resourceRateLimitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Get("mailbox").(string)
+	if id == "" {
+		id = d.Get("domain").(string)
+	}
+	d.SetId(id)
+
+	err := updateRateLimit(ctx, d, d.Get("rate_limit").(string), m.(*APIClient))
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceRateLimitRead(ctx, d, m)
+}
+
+func resourceRateLimitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request, _ := rateLimitRequests(ctx, d, c)
+	rl, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rateLimit := rateLimitString(rl)
+	if rateLimit == "" {
+		return removeFromState(d, "rate limit")
+	}
+
+	err = d.Set("rate_limit", rateLimit)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceRateLimitUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := updateRateLimit(ctx, d, d.Get("rate_limit").(string), m.(*APIClient))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRateLimitRead(ctx, d, m)
+}
+
+func resourceRateLimitDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// an empty rate limit removes it
+	err := updateRateLimit(ctx, d, "", m.(*APIClient))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateRateLimit sets the rate limit of the mailbox or domain, removes it if it is empty
+func updateRateLimit(ctx context.Context, d *schema.ResourceData, value string, c *APIClient) error {
+	_, mailcowUpdateRequest := rateLimitRequests(ctx, d, c)
+	if value == "" {
+		mailcowUpdateRequest.SetAttr("rl_value", "")
+		mailcowUpdateRequest.SetAttr("rl_frame", "s")
+	} else {
+		rl, err := parseRateLimit(value)
+		if err != nil {
+			return err
+		}
+		mailcowUpdateRequest.SetAttr("rl_value", rl.value)
+		mailcowUpdateRequest.SetAttr("rl_frame", rl.frame)
+	}
+	mailcowUpdateRequest.SetItem(d.Id())
+
+	response, err := api.MailcowUpdateExecute(ctx, c.client, mailcowUpdateRequest)
+	if err != nil {
+		return err
+	}
+	return checkResponse(response, mailcowUpdateRequest.ResourceName, d.Id())
+}
@@ -0,0 +1,62 @@
+package mailcow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRateLimit(t *testing.T) {
+	domain := fmt.Sprintf("with-ratelimit-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRateLimit(domain, "10x"),
+				ExpectError: regexp.MustCompile(`Invalid rate limit`),
+			},
+			{
+				Config: testAccResourceRateLimit(domain, "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_ratelimit.ratelimit", "mailbox", "demo@"+domain),
+					resource.TestCheckResourceAttr("mailcow_ratelimit.ratelimit", "rate_limit", "10s"),
+				),
+			},
+			{
+				Config: testAccResourceRateLimit(domain, "500h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_ratelimit.ratelimit", "rate_limit", "500h"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_ratelimit.ratelimit",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceRateLimit(domain string, rateLimit string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  domain     = mailcow_domain.domain.domain
+  local_part = "demo"
+  full_name  = "Demo User"
+  password   = "secret-password"
+}
+
+resource "mailcow_ratelimit" "ratelimit" {
+  mailbox    = mailcow_mailbox.mailbox.address
+  rate_limit = "%[2]s"
+}
+`, domain, rateLimit)
+}
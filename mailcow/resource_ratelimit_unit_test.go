@@ -0,0 +1,112 @@
+package mailcow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceRateLimitMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceRateLimit()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"mailbox":    "demo@440044.xyz",
+		"rate_limit": "10s",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":         "demo@440044.xyz",
+		"rate_limit": "10s",
+	})
+	rl := mock.get("rl-mbox", "demo@440044.xyz")
+	if rl["value"] != "10" || rl["frame"] != "s" {
+		t.Fatalf("unexpected rate limit %v", rl)
+	}
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"mailbox":    "demo@440044.xyz",
+		"rate_limit": "250h",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"rate_limit": "250h",
+	})
+
+	imported := testMockImport(t, res, "demo@440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"mailbox":    "demo@440044.xyz",
+		"rate_limit": "250h",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if rl := mock.get("rl-mbox", "demo@440044.xyz"); len(rl) != 0 {
+		t.Fatalf("rate limit not removed: %v", rl)
+	}
+
+	domain := testMockApply(t, res, nil, map[string]interface{}{
+		"domain":     "440044.xyz",
+		"rate_limit": "1d",
+	}, meta)
+	testMockCheckAttrs(t, domain, map[string]string{
+		"id":         "440044.xyz",
+		"rate_limit": "1d",
+	})
+	imported = testMockImport(t, res, "440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"domain":     "440044.xyz",
+		"rate_limit": "1d",
+	})
+}
+
+func TestResourceRateLimitValidation(t *testing.T) {
+	res := resourceRateLimit()
+
+	for _, raw := range []map[string]interface{}{
+		{"mailbox": "demo@440044.xyz", "rate_limit": "10x"},
+		{"mailbox": "demo@440044.xyz", "rate_limit": ""},
+		{"mailbox": "demo@440044.xyz", "rate_limit": "s"},
+		{"mailbox": "demo@440044.xyz", "rate_limit": "-1s"},
+		{"mailbox": "demo@440044.xyz", "domain": "440044.xyz", "rate_limit": "10s"},
+		{"rate_limit": "10s"},
+	} {
+		if diags := res.Validate(terraform.NewResourceConfigRaw(raw)); !diags.HasError() {
+			t.Errorf("expected %v to be invalid", raw)
+		}
+	}
+	if diags := resourceDomain().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"domain":     "440044.xyz",
+		"rate_limit": "10x",
+	})); !diags.HasError() {
+		t.Error("expected rate_limit 10x of domain to be invalid")
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	for value, expected := range map[string]rateLimit{
+		"10s":  {value: 10, frame: "s"},
+		"0m":   {value: 0, frame: "m"},
+		"250h": {value: 250, frame: "h"},
+		"1d":   {value: 1, frame: "d"},
+	} {
+		rl, err := parseRateLimit(value)
+		if err != nil {
+			t.Errorf("expected %q to be valid: %v", value, err)
+			continue
+		}
+		if *rl != expected || rl.String() != value {
+			t.Errorf("%q: expected %v, got %v", value, expected, *rl)
+		}
+	}
+	for _, value := range []string{"", "10", "10x", "s", "1.5h", " 10s", "10S"} {
+		if _, err := parseRateLimit(value); err == nil {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+
+	if rl := rateLimitString(false); rl != "" {
+		t.Errorf("expected no rate limit, got %q", rl)
+	}
+	if rl := rateLimitString(map[string]interface{}{"value": "10", "frame": "s"}); rl != "10s" {
+		t.Errorf("expected 10s, got %q", rl)
+	}
+}
@@ -0,0 +1,143 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+func resourceRecipientMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRecipientMapCreate,
+		ReadContext:   resourceRecipientMapRead,
+		UpdateContext: resourceRecipientMapUpdate,
+		DeleteContext: resourceRecipientMapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRecipientMapImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"recipient_map_old": {
+				Type:        schema.TypeString,
+				Description: "the rewritten recipient, an e-mail address or a domain",
+				Required:    true,
+			},
+			"recipient_map_new": {
+				Type:        schema.TypeString,
+				Description: "the recipient the mails are delivered to instead, an e-mail address or a domain",
+				Required:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is recipient map active or not",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// resourceRecipientMapImport accepts the id or the old recipient
+func resourceRecipientMapImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	c := m.(*APIClient)
+	id, err := getRecipientMapId(ctx, c.client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(*id)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRecipientMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	recipientMapOld := d.Get("recipient_map_old").(string)
+
+	mailcowCreateRequest := api.NewCreateRecipientMapRequest()
+
+	err := mailcowCreate(ctx, resourceRecipientMap(), d, recipientMapOld, nil, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new recipient map, but the old recipient is unique
+	id, err := getRecipientMapId(ctx, c.client, recipientMapOld)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*id)
+
+	return resourceRecipientMapRead(ctx, d, m)
+}
+
+func getRecipientMapId(ctx context.Context, client *api.APIClient, recipientMapOld string) (*string, error) {
+	request := client.Api.MailcowGetRecipientMaps(ctx)
+
+	recipientMaps, err := readAllRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recipientMap := range recipientMaps {
+		if recipientMap["recipient_map_old"] == recipientMapOld {
+			id := fmt.Sprint(recipientMap["id"])
+			return &id, nil
+		}
+	}
+	return nil, fmt.Errorf("recipient map not found: %s", recipientMapOld)
+}
+
+func resourceRecipientMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request := c.client.Api.MailcowGetRecipientMap(ctx, id)
+
+	recipientMap, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if recipientMap["id"] == nil {
+		return removeFromState(d, "recipient map")
+	}
+
+	err = setResourceData(resourceRecipientMap(), d, &recipientMap, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceRecipientMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateRecipientMapRequest()
+
+	err := mailcowUpdate(ctx, resourceRecipientMap(), d, nil, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRecipientMapRead(ctx, d, m)
+}
+
+func resourceRecipientMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteRecipientMapRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
@@ -0,0 +1,59 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRecipientMap(t *testing.T) {
+	oldDomain := fmt.Sprintf("with-recipient-map-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	newDomain := fmt.Sprintf("with-recipient-map-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRecipientMap(oldDomain, newDomain, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_recipient_map.recipient_map", "recipient_map_old", oldDomain),
+					resource.TestCheckResourceAttr("mailcow_recipient_map.recipient_map", "recipient_map_new", newDomain),
+					resource.TestCheckResourceAttr("mailcow_recipient_map.recipient_map", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceRecipientMap(oldDomain, newDomain, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_recipient_map.recipient_map", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_recipient_map.recipient_map",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "mailcow_recipient_map.recipient_map",
+				ImportState:       true,
+				ImportStateId:     oldDomain,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceRecipientMap(oldDomain string, newDomain string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[2]s"
+}
+
+resource "mailcow_recipient_map" "recipient_map" {
+  recipient_map_old = "%[1]s"
+  recipient_map_new = mailcow_domain.domain.domain
+  active            = %[3]s
+}
+`, oldDomain, newDomain, active)
+}
@@ -0,0 +1,59 @@
+package mailcow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceRecipientMapMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceRecipientMap()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"recipient_map_old": "old.xyz",
+		"recipient_map_new": "440044.xyz",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":     "1",
+		"active": "true",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"recipient_map_old": "old.xyz",
+		"recipient_map_new": "440044.xyz",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"recipient_map_old": "old.xyz",
+		"recipient_map_new": "550055.xyz",
+		"active":            false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":                "1",
+		"recipient_map_new": "550055.xyz",
+		"active":            "false",
+	})
+
+	for _, importId := range []string{"1", "old.xyz"} {
+		imported := testMockImport(t, res, importId, meta)
+		testMockCheckAttrs(t, imported, map[string]string{
+			"id":                "1",
+			"recipient_map_old": "old.xyz",
+			"recipient_map_new": "550055.xyz",
+			"active":            "false",
+		})
+	}
+
+	_, err := res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "unknown.xyz"}), meta)
+	if err == nil {
+		t.Error("expected an error importing an unknown old recipient")
+	}
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("recipient_map", "1") != nil {
+		t.Fatal("recipient map not deleted")
+	}
+}
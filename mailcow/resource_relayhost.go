@@ -0,0 +1,155 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+// relayhostNone is the relayhost of domains and mailboxes without a sender-dependent relayhost
+const relayhostNone = "0"
+
+func resourceRelayhost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRelayhostCreate,
+		ReadContext:   resourceRelayhostRead,
+		UpdateContext: resourceRelayhostUpdate,
+		DeleteContext: resourceRelayhostDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRelayhostImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "the next hop the mails are relayed to, e.g. \"[smtp.example.org]:587\"",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "username for the authentication at the relayhost",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "password for the authentication at the relayhost",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is relayhost active or not",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceRelayhostImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRelayhostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	hostname := d.Get("hostname").(string)
+	username := d.Get("username").(string)
+
+	mailcowCreateRequest := api.NewCreateRelayhostRequest()
+
+	err := mailcowCreate(ctx, resourceRelayhost(), d, hostname, nil, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new relayhost, the newest one with the hostname and username is it
+	relayhosts, err := readAllRequest(c.client.Api.MailcowGetRelayhosts(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, ok := newestId(relayhosts, func(relayhost map[string]interface{}) bool {
+		return relayhost["hostname"] == hostname && relayhost["username"] == username
+	})
+	if !ok {
+		return diag.Errorf("relayhost %s not found", hostname)
+	}
+	d.SetId(id)
+
+	return resourceRelayhostRead(ctx, d, m)
+}
+
+func resourceRelayhostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request := c.client.Api.MailcowGetRelayhost(ctx, id)
+
+	relayhost, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if relayhost["id"] == nil {
+		return removeFromState(d, "relayhost")
+	}
+
+	err = setResourceData(resourceRelayhost(), d, &relayhost, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceRelayhostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateRelayhostRequest()
+
+	err := mailcowUpdate(ctx, resourceRelayhost(), d, nil, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRelayhostRead(ctx, d, m)
+}
+
+func resourceRelayhostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteRelayhostRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
+
+// relayhostSchema is the argument of domains and mailboxes referencing a relayhost
+func relayhostSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "id of the sender-dependent relayhost (mailcow_relayhost) used instead of direct delivery, \"0\" for none",
+		Default:     relayhostNone,
+		Optional:    true,
+	}
+}
+
+// updateRelayhost sets the relayhost of a created domain or mailbox, mailcow ignores it on add
+func updateRelayhost(ctx context.Context, d *schema.ResourceData, mailcowUpdateRequest *api.MailcowUpdateRequest, c *APIClient) error {
+	if d.Get("relayhost").(string) == relayhostNone {
+		return nil
+	}
+	mailcowUpdateRequest.SetAttr("relayhost", d.Get("relayhost"))
+	mailcowUpdateRequest.SetItem(d.Id())
+
+	response, err := api.MailcowUpdateExecute(ctx, c.client, mailcowUpdateRequest)
+	if err != nil {
+		return err
+	}
+	return checkResponse(response, mailcowUpdateRequest.ResourceName, d.Id())
+}
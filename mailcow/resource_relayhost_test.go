@@ -0,0 +1,65 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRelayhost(t *testing.T) {
+	domain := fmt.Sprintf("with-relayhost-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	hostname := fmt.Sprintf("[smtp-%s.example.org]:587", randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRelayhost(domain, hostname, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_relayhost.relayhost", "hostname", hostname),
+					resource.TestCheckResourceAttr("mailcow_relayhost.relayhost", "username", "relay@"+domain),
+					resource.TestCheckResourceAttr("mailcow_relayhost.relayhost", "active", "true"),
+					resource.TestCheckResourceAttrPair("mailcow_domain.domain", "relayhost", "mailcow_relayhost.relayhost", "id"),
+					resource.TestCheckResourceAttrPair("mailcow_mailbox.mailbox", "relayhost", "mailcow_relayhost.relayhost", "id"),
+				),
+			},
+			{
+				Config: testAccResourceRelayhost(domain, hostname, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_relayhost.relayhost", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_relayhost.relayhost",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceRelayhost(domain string, hostname string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_relayhost" "relayhost" {
+  hostname = "%[2]s"
+  username = "relay@%[1]s"
+  password = "secret-password"
+  active   = %[3]s
+}
+
+resource "mailcow_domain" "domain" {
+  domain    = "%[1]s"
+  relayhost = mailcow_relayhost.relayhost.id
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  domain     = mailcow_domain.domain.domain
+  local_part = "demo"
+  full_name  = "Demo User"
+  password   = "secret-password"
+  relayhost  = mailcow_relayhost.relayhost.id
+}
+`, domain, hostname, active)
+}
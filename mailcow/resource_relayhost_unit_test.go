@@ -0,0 +1,96 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceRelayhostMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceRelayhost()
+
+	// the relayhost with the same hostname but another username gets another id
+	testMockApply(t, res, nil, map[string]interface{}{
+		"hostname": "[smtp.440044.xyz]:587",
+		"username": "other",
+		"password": "secret",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"hostname": "[smtp.440044.xyz]:587",
+		"username": "relay",
+		"password": "secret",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":       "2",
+		"username": "relay",
+		"active":   "true",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"hostname": "[smtp.440044.xyz]:465",
+		"username": "relay",
+		"password": "changed",
+		"active":   false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":       "2",
+		"hostname": "[smtp.440044.xyz]:465",
+		"password": "changed",
+		"active":   "false",
+	})
+
+	imported := testMockImport(t, res, "2", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"hostname": "[smtp.440044.xyz]:465",
+		"username": "relay",
+		"active":   "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("relayhost", "2") != nil {
+		t.Fatal("relayhost not deleted")
+	}
+}
+
+func TestResourceRelayhostDomainMailboxMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+
+	domain := testMockApply(t, resourceDomain(), nil, map[string]interface{}{
+		"domain":    "440044.xyz",
+		"relayhost": "1",
+	}, meta)
+	if relayhost := mock.get("domain", "440044.xyz")["relayhost"]; relayhost != "1" {
+		t.Fatalf("expected relayhost 1 of domain, got %v", relayhost)
+	}
+	domain = testMockRefresh(t, resourceDomain(), domain, meta)
+	testMockCheckAttrs(t, domain, map[string]string{
+		"relayhost": "1",
+	})
+
+	mailbox := testMockApply(t, resourceMailbox(), nil, map[string]interface{}{
+		"domain":     "440044.xyz",
+		"local_part": "demo",
+		"full_name":  "Demo User",
+		"password":   "secret-password",
+	}, meta)
+	testMockCheckAttrs(t, mailbox, map[string]string{
+		"relayhost": relayhostNone,
+	})
+
+	mailbox = testMockApply(t, resourceMailbox(), mailbox, map[string]interface{}{
+		"domain":     "440044.xyz",
+		"local_part": "demo",
+		"full_name":  "Demo User",
+		"password":   "secret-password",
+		"relayhost":  "2",
+	}, meta)
+	testMockCheckAttrs(t, mailbox, map[string]string{
+		"relayhost": "2",
+	})
+	attributes := mock.get("mailbox", "demo@440044.xyz")["attributes"].(map[string]interface{})
+	if attributes["relayhost"] != "2" {
+		t.Fatalf("expected relayhost 2 of mailbox, got %v", attributes["relayhost"])
+	}
+}
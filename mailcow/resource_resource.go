@@ -0,0 +1,160 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+// resourceKinds are the kinds of SOGo resources
+var resourceKinds = []string{
+	"location",
+	"group",
+	"thing",
+}
+
+func resourceResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResourceCreate,
+		ReadContext:   resourceResourceRead,
+		UpdateContext: resourceResourceUpdate,
+		DeleteContext: resourceResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:        schema.TypeString,
+				Description: "description of the resource, mailcow derives the local part of its e-mail address from it on creation",
+				Required:    true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "domain of the resource",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Description:  "kind of the resource. One of: location, group, thing.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceKinds, false),
+			},
+			"multiple_bookings": {
+				Type:         schema.TypeInt,
+				Description:  "number of bookings at the same time, 0 for one booking only, -1 for unlimited",
+				Default:      0,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is resource active or not",
+				Default:     true,
+				Optional:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "e-mail address of the resource",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceResourceImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	description := d.Get("description").(string)
+	domain := d.Get("domain").(string)
+
+	mailcowCreateRequest := api.NewCreateResourceRequest()
+
+	exclude := []string{"name"}
+	err := mailcowCreate(ctx, resourceResource(), d, description, &exclude, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow derives the e-mail address of the new resource from the description, which is unique per domain
+	resources, err := readAllRequest(c.client.Api.MailcowGetResources(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, resource := range resources {
+		if resource["description"] == description && resource["domain"] == domain {
+			d.SetId(fmt.Sprint(resource["name"]))
+			return resourceResourceRead(ctx, d, m)
+		}
+	}
+	return diag.Errorf("resource %s of domain %s not found", description, domain)
+}
+
+func resourceResourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	resource, err := getResource(ctx, c.client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resource == nil {
+		return removeFromState(d, "resource")
+	}
+
+	err = setResourceData(resourceResource(), d, &resource, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+// getResource returns the resource with the e-mail address, nil if mailcow does not know it
+func getResource(ctx context.Context, client *api.APIClient, name string) (map[string]interface{}, error) {
+	resources, err := readAllRequest(client.Api.MailcowGetResources(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources {
+		if resource["name"] == name {
+			return resource, nil
+		}
+	}
+	return nil, nil
+}
+
+func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateResourceRequest()
+
+	exclude := []string{"name"}
+	err := mailcowUpdate(ctx, resourceResource(), d, &exclude, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceResourceRead(ctx, d, m)
+}
+
+func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteResourceRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
@@ -0,0 +1,59 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceResource(t *testing.T) {
+	domain := fmt.Sprintf("with-resource-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceResource(domain, "location", 0, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "description", "Meeting Room"),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "domain", domain),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "name", "MeetingRoom@"+domain),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "kind", "location"),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "multiple_bookings", "0"),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceResource(domain, "thing", -1, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "kind", "thing"),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "multiple_bookings", "-1"),
+					resource.TestCheckResourceAttr("mailcow_resource.resource", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_resource.resource",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceResource(domain string, kind string, multipleBookings int, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_resource" "resource" {
+  description       = "Meeting Room"
+  domain            = mailcow_domain.domain.domain
+  kind              = "%[2]s"
+  multiple_bookings = %[3]d
+  active            = %[4]s
+}
+`, domain, kind, multipleBookings, active)
+}
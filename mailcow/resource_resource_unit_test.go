@@ -0,0 +1,68 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceResourceMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceResource()
+
+	testMockApply(t, res, nil, map[string]interface{}{
+		"description": "Room 1",
+		"domain":      "other.xyz",
+		"kind":        "location",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"description": "Room 1",
+		"domain":      "440044.xyz",
+		"kind":        "location",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":                "Room1@440044.xyz",
+		"name":              "Room1@440044.xyz",
+		"multiple_bookings": "0",
+		"active":            "true",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"description":       "Room 1 (projector)",
+		"domain":            "440044.xyz",
+		"kind":              "thing",
+		"multiple_bookings": -1,
+		"active":            false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":                "Room1@440044.xyz",
+		"description":       "Room 1 (projector)",
+		"kind":              "thing",
+		"multiple_bookings": "-1",
+		"active":            "false",
+	})
+
+	imported := testMockImport(t, res, "Room1@440044.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"description": "Room 1 (projector)",
+		"domain":      "440044.xyz",
+		"kind":        "thing",
+	})
+
+	data := testMockReadData(t, dataSourceResource(), map[string]interface{}{
+		"name": "Room1@440044.xyz",
+	}, meta)
+	testMockCheckAttrs(t, data, map[string]string{
+		"id":                "Room1@440044.xyz",
+		"description":       "Room 1 (projector)",
+		"domain":            "440044.xyz",
+		"kind":              "thing",
+		"multiple_bookings": "-1",
+		"active":            "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("resource", "Room1@440044.xyz") != nil {
+		t.Fatal("resource not deleted")
+	}
+}
@@ -0,0 +1,148 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+const (
+	sieveFilterTypePrefilter  = "prefilter"
+	sieveFilterTypePostfilter = "postfilter"
+)
+
+func resourceSieveFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSieveFilterCreate,
+		ReadContext:   resourceSieveFilterRead,
+		UpdateContext: resourceSieveFilterUpdate,
+		DeleteContext: resourceSieveFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSieveFilterImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "e-mail address of the mailbox the filter belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filter_type": {
+				Type:         schema.TypeString,
+				Description:  "whether the filter runs before (prefilter) or after (postfilter) the filters of the user. One of: prefilter, postfilter.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{sieveFilterTypePrefilter, sieveFilterTypePostfilter}, false),
+			},
+			"script_desc": {
+				Type:        schema.TypeString,
+				Description: "description of the filter",
+				Required:    true,
+			},
+			"script_data": {
+				Type:             schema.TypeString,
+				Description:      "the sieve script, its syntax is checked at plan time",
+				Required:         true,
+				ValidateDiagFunc: validateSieveScriptDiag,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is filter active or not, mailcow deactivates the other filters of the same type of the mailbox",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSieveFilterImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSieveFilterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	username := d.Get("username").(string)
+	filterType := d.Get("filter_type").(string)
+	scriptDesc := d.Get("script_desc").(string)
+
+	mailcowCreateRequest := api.NewCreateSieveFilterRequest()
+
+	err := mailcowCreate(ctx, resourceSieveFilter(), d, username+"/"+scriptDesc, nil, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new filter, the newest one with the description is it
+	filters, err := readAllRequest(c.client.Api.MailcowGetSieveFilters(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, ok := newestId(filters, func(filter map[string]interface{}) bool {
+		return filter["username"] == username && filter["filter_type"] == filterType && filter["script_desc"] == scriptDesc
+	})
+	if !ok {
+		return diag.Errorf("%s %s of mailbox %s not found", filterType, scriptDesc, username)
+	}
+	d.SetId(id)
+
+	return resourceSieveFilterRead(ctx, d, m)
+}
+
+func resourceSieveFilterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	filters, err := readAllRequest(c.client.Api.MailcowGetSieveFilters(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var filter map[string]interface{}
+	for _, candidate := range filters {
+		if fmt.Sprint(candidate["id"]) == id {
+			filter = candidate
+			break
+		}
+	}
+	if filter == nil {
+		return removeFromState(d, "sieve filter")
+	}
+
+	err = setResourceData(resourceSieveFilter(), d, &filter, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceSieveFilterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateSieveFilterRequest()
+
+	exclude := []string{"username"}
+	err := mailcowUpdate(ctx, resourceSieveFilter(), d, &exclude, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSieveFilterRead(ctx, d, m)
+}
+
+func resourceSieveFilterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteSieveFilterRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
@@ -0,0 +1,67 @@
+package mailcow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSieveFilter(t *testing.T) {
+	domain := fmt.Sprintf("with-sieve-filter-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSieveFilter(domain, "fileinto \\\"Junk\\\"", "true"),
+				ExpectError: regexp.MustCompile(`Invalid sieve script`),
+			},
+			{
+				Config: testAccResourceSieveFilter(domain, "fileinto \\\"Junk\\\";", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_sieve_filter.filter", "username", "demo@"+domain),
+					resource.TestCheckResourceAttr("mailcow_sieve_filter.filter", "filter_type", "prefilter"),
+					resource.TestCheckResourceAttr("mailcow_sieve_filter.filter", "script_desc", "junk"),
+					resource.TestCheckResourceAttr("mailcow_sieve_filter.filter", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceSieveFilter(domain, "discard;", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_sieve_filter.filter", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_sieve_filter.filter",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSieveFilter(domain string, action string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  domain     = mailcow_domain.domain.domain
+  local_part = "demo"
+  full_name  = "Demo User"
+  password   = "secret-password"
+}
+
+resource "mailcow_sieve_filter" "filter" {
+  username    = mailcow_mailbox.mailbox.address
+  filter_type = "prefilter"
+  script_desc = "junk"
+  script_data = "require \"fileinto\";\nif header :contains \"X-Spam-Flag\" \"YES\" {\n  %[2]s\n}\n"
+  active      = %[3]s
+}
+`, domain, action, active)
+}
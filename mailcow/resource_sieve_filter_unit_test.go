@@ -0,0 +1,89 @@
+package mailcow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSieveFilterMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceSieveFilter()
+
+	testMockApply(t, res, nil, map[string]interface{}{
+		"username":    "demo@440044.xyz",
+		"filter_type": "postfilter",
+		"script_desc": "spam",
+		"script_data": "keep;",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"username":    "demo@440044.xyz",
+		"filter_type": "prefilter",
+		"script_desc": "spam",
+		"script_data": "require \"fileinto\";\nif header :contains \"X-Spam-Flag\" \"YES\" {\n  fileinto \"Junk\";\n}\n",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":          "2",
+		"filter_type": "prefilter",
+		"active":      "true",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"username":    "demo@440044.xyz",
+		"script_desc": "spam",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"username":    "demo@440044.xyz",
+		"filter_type": "prefilter",
+		"script_desc": "discard spam",
+		"script_data": "if header :contains \"X-Spam-Flag\" \"YES\" { discard; }",
+		"active":      false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":          "2",
+		"script_desc": "discard spam",
+		"script_data": "if header :contains \"X-Spam-Flag\" \"YES\" { discard; }",
+		"active":      "false",
+	})
+
+	imported := testMockImport(t, res, "2", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"username":    "demo@440044.xyz",
+		"filter_type": "prefilter",
+		"script_desc": "discard spam",
+		"active":      "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("filter", "2") != nil {
+		t.Fatal("sieve filter not deleted")
+	}
+}
+
+func TestResourceSieveFilterValidation(t *testing.T) {
+	res := resourceSieveFilter()
+
+	diags := res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"username":    "demo@440044.xyz",
+		"filter_type": "prefilter",
+		"script_desc": "typo",
+		"script_data": "if header :contains \"subject\" \"x\" {\n  fileinto \"Junk\"\n}",
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected the sieve script to be invalid")
+	}
+
+	diags = res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"username":    "demo@440044.xyz",
+		"filter_type": "filter",
+		"script_desc": "type",
+		"script_data": "keep;",
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected the filter type to be invalid")
+	}
+}
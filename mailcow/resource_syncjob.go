@@ -5,12 +5,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/l-with/terraform-provider-mailcow/api"
 )
 
+// syncjobNotSent are the arguments not sent to mailcow, the computed status of the last run and keep_log
+var syncjobNotSent = []string{
+	"last_run",
+	"success",
+	"exit_status",
+	"is_running",
+	"log",
+	"keep_log",
+}
+
 func resourceSyncjob() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSyncjobCreate,
@@ -152,23 +164,116 @@ func resourceSyncjob() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+
+			// status of the last run
+			"last_run": {
+				Type:        schema.TypeString,
+				Description: "time of the last run, empty if the sync job did not run yet",
+				Computed:    true,
+			},
+			"success": {
+				Type:        schema.TypeBool,
+				Description: "if the last run was successful",
+				Computed:    true,
+			},
+			"exit_status": {
+				Type:        schema.TypeString,
+				Description: "exit status of imapsync of the last run, e.g. \"EXIT_OK\"",
+				Computed:    true,
+			},
+			"is_running": {
+				Type:        schema.TypeBool,
+				Description: "if the sync job is running",
+				Computed:    true,
+			},
+			"log": {
+				Type:        schema.TypeString,
+				Description: "imapsync log of the last run, empty if keep_log is false",
+				Computed:    true,
+			},
+			"keep_log": {
+				Type:        schema.TypeBool,
+				Description: "if the imapsync log of the last run is kept in log, the log can grow the state considerably",
+				Default:     true,
+				Optional:    true,
+			},
 		},
 	}
 }
 
-func resourceSyncjobImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+// resourceSyncjobImport imports a sync job by "id", "username/id" or "user2:host1:user1"
+func resourceSyncjobImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*APIClient)
+
+	// the default of keep_log is not applied on import
+	err := d.Set("keep_log", true)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.SplitN(d.Id(), ":", 3)
+	if len(parts) != 3 {
+		return resourceSyncjobImportId(ctx, d, c)
+	}
+	username, host1, user1 := parts[0], parts[1], parts[2]
+
+	syncJobs, err := getSyncJobs(ctx, c, username)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, 1)
+	for _, syncJob := range syncJobs {
+		if strings.EqualFold(fmt.Sprint(syncJob["host1"]), host1) && fmt.Sprint(syncJob["user1"]) == user1 {
+			ids = append(ids, fmt.Sprint(syncJob["id"]))
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("syncjob user2=%s, host1=%s and user1=%s not found", username, host1, user1)
+	case 1:
+		d.SetId(ids[0])
+		err = d.Set("username", username)
+		if err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+	return nil, fmt.Errorf("syncjob user2=%s, host1=%s and user1=%s is ambiguous, found the ids %s", username, host1, user1, strings.Join(ids, ", "))
+}
+
+// resourceSyncjobImportId imports a sync job by "id" or "username/id"
+func resourceSyncjobImportId(ctx context.Context, d *schema.ResourceData, c *APIClient) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	username := ""
+	if index := strings.LastIndex(id, "/"); index >= 0 {
+		username, id = id[:index], id[index+1:]
+	}
+	syncJob, err := getSyncJobById(ctx, c, id)
+	if err != nil {
+		return nil, err
+	}
+	if syncJob == nil {
+		return nil, fmt.Errorf("syncjob %s not found", id)
+	}
+	if username != "" && fmt.Sprint(syncJob["user2"]) != username {
+		return nil, fmt.Errorf("syncjob %s belongs to %s, not to %s", id, syncJob["user2"], username)
+	}
+	d.SetId(id)
+	// with the username read looks up the sync jobs of the mailbox only
+	err = d.Set("username", syncJob["user2"])
+	if err != nil {
+		return nil, err
+	}
 	return []*schema.ResourceData{d}, nil
 }
 
 func resourceSyncjobCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
 	c := m.(*APIClient)
 
 	mailcowCreateRequest := api.NewCreateSyncjobRequest()
 
 	log.Print("[TRACE] resourceSyncjobCreate delete1: ", d.Get("delete1"))
-	createRequestSet(mailcowCreateRequest, resourceSyncjob(), d, nil, nil)
+	createRequestSet(mailcowCreateRequest, resourceSyncjob(), d, &syncjobNotSent, nil)
 
 	username := d.Get("username").(string)
 	user1 := d.Get("user1").(string)
@@ -184,13 +289,16 @@ func resourceSyncjobCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	syncJob, err := getSyncJob(ctx, c, username, "user1", d.Get("user1").(string))
-	if syncJob == nil {
+	if err != nil {
 		return diag.FromErr(err)
 	}
+	if syncJob == nil {
+		return diag.Errorf("syncjob user2=%s and user1=%s not found", username, user1)
+	}
 
 	d.SetId(fmt.Sprint(syncJob["id"].(float64)))
 
-	return diags
+	return resourceSyncjobRead(ctx, d, m)
 }
 
 func resourceSyncjobRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -200,12 +308,23 @@ func resourceSyncjobRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	c := m.(*APIClient)
 	id := d.Id()
-	emailAddress := d.Get("username").(string)
 
-	syncJob, err := getSyncJob(ctx, c, emailAddress, "id", id)
-	if syncJob == nil {
+	// listing all sync jobs loads the sync jobs of every mailbox, it is only needed without a username
+	var syncJob map[string]interface{}
+	keepLog := d.Get("keep_log").(bool)
+	if username := d.Get("username").(string); username != "" {
+		syncJob, err = getSyncJob(ctx, c, username, "id", id)
+	} else if keepLog {
+		syncJob, err = getSyncJobWithLog(ctx, c, id)
+	} else {
+		syncJob, err = getSyncJobById(ctx, c, id)
+	}
+	if err != nil {
 		return diag.FromErr(err)
 	}
+	if syncJob == nil {
+		return removeFromState(d, "syncjob")
+	}
 
 	syncJob["username"] = syncJob["user2"]
 	for _, argument := range []string{
@@ -230,6 +349,19 @@ func resourceSyncjobRead(ctx context.Context, d *schema.ResourceData, m interfac
 		log.Print("[TRACE] resourceSyncjobRead mailbox[", argument, "]: ", syncJob[argument])
 	}
 
+	err = setSyncJobStatus(d, syncJob)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	syncJobLogValue := ""
+	if keepLog {
+		syncJobLogValue = syncJobLog(syncJob)
+	}
+	err = d.Set("log", syncJobLogValue)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	for _, argumentBool := range []string{
 		"active",
 		"automap",
@@ -256,34 +388,121 @@ func resourceSyncjobRead(ctx context.Context, d *schema.ResourceData, m interfac
 	return diags
 }
 
+// getSyncJob returns the sync job of emailAddress matching the attribute, nil if there is none
 func getSyncJob(ctx context.Context, c *APIClient, emailAddress string, attributeKey string, attributeValue string) (map[string]interface{}, error) {
+	syncJobs, err := getSyncJobs(ctx, c, emailAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, syncJob := range syncJobs {
+		if attributeValue == fmt.Sprint(syncJob[attributeKey]) {
+			return syncJob, nil
+		}
+	}
+	return nil, nil
+}
+
+// setSyncJobStatus sets the status of the last run of the sync job without the log
+func setSyncJobStatus(d *schema.ResourceData, syncJob map[string]interface{}) error {
+	status := map[string]interface{}{
+		"last_run":    "",
+		"success":     fmt.Sprint(syncJob["success"]) == "1",
+		"exit_status": "",
+		"is_running":  fmt.Sprint(syncJob["is_running"]) == "1",
+	}
+	// mailcow returns null for a sync job which did not run yet
+	for _, argument := range []string{"last_run", "exit_status"} {
+		if syncJob[argument] != nil {
+			status[argument] = fmt.Sprint(syncJob[argument])
+		}
+	}
+	for argument, value := range status {
+		err := d.Set(argument, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncJobLog returns the imapsync log of the sync job, which older mailcow versions return as returned_text
+func syncJobLog(syncJob map[string]interface{}) string {
+	for _, key := range []string{"log", "returned_text"} {
+		if syncJob[key] != nil {
+			return fmt.Sprint(syncJob[key])
+		}
+	}
+	return ""
+}
+
+// getSyncJobWithLog returns the sync job with the id including its log, nil if there is none,
+// it lists all sync jobs to find the mailbox and is only used if the username is not known
+func getSyncJobWithLog(ctx context.Context, c *APIClient, id string) (map[string]interface{}, error) {
+	syncJob, err := getSyncJobById(ctx, c, id)
+	if err != nil || syncJob == nil {
+		return syncJob, err
+	}
+	// only the sync jobs of a mailbox are returned with their logs
+	syncJobWithLog, err := getSyncJob(ctx, c, fmt.Sprint(syncJob["user2"]), "id", id)
+	if err != nil {
+		return nil, err
+	}
+	if syncJobWithLog == nil {
+		return syncJob, nil
+	}
+	return syncJobWithLog, nil
+}
+
+// getSyncJobById returns the sync job with the id, nil if there is none
+func getSyncJobById(ctx context.Context, c *APIClient, id string) (map[string]interface{}, error) {
+	syncJobs, err := readAllRequest(c.client.Api.MailcowGetSyncjobs(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, syncJob := range syncJobs {
+		if fmt.Sprint(syncJob["id"]) == id {
+			return syncJob, nil
+		}
+	}
+	return nil, nil
+}
+
+// getSyncJobs returns the sync jobs of emailAddress
+func getSyncJobs(ctx context.Context, c *APIClient, emailAddress string) ([]map[string]interface{}, error) {
 	request := c.client.Api.MailcowGetSyncjob(ctx, emailAddress)
-	log.Print("[TRACE] getSyncJob emailAddress: ", emailAddress)
+	log.Print("[TRACE] getSyncJobs emailAddress: ", emailAddress)
 
+	result := make([]map[string]interface{}, 0)
 	response, err := request.MailcowExecute()
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return result, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	log.Print("[TRACE] getSyncJob response.Body: ", response.Body)
-	syncJobs := make([]map[string]interface{}, 0)
-	err = json.NewDecoder(response.Body).Decode(&syncJobs)
+	log.Print("[TRACE] getSyncJobs response.Body: ", response.Body)
+	var decoded interface{}
+	err = json.NewDecoder(response.Body).Decode(&decoded)
 	if err != nil {
 		return nil, err
 	}
+	// mailcow answers with an empty object if the mailbox has no sync jobs
+	syncJobs, ok := decoded.([]interface{})
+	if !ok {
+		return result, nil
+	}
 
-	var syncJob map[string]interface{} = nil
-	for _, currentSyncJob := range syncJobs {
-		currentUsername := currentSyncJob["user2"].(string)
-		if currentUsername == emailAddress && attributeValue == fmt.Sprint(currentSyncJob[attributeKey]) {
-			syncJob = currentSyncJob
-			break
+	for _, item := range syncJobs {
+		syncJob, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if fmt.Sprint(syncJob["user2"]) == emailAddress {
+			result = append(result, syncJob)
 		}
 	}
-	if syncJob == nil {
-		return nil, fmt.Errorf("syncjob user2=%s and %s=%s not found", emailAddress, attributeKey, attributeValue)
-	}
-	return syncJob, nil
+	return result, nil
 }
 
 func resourceSyncjobUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -291,7 +510,7 @@ func resourceSyncjobUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	mailcowUpdateRequest := api.NewUpdateSyncjobRequest()
 
-	err := mailcowUpdate(ctx, resourceSyncjob(), d, nil, nil, mailcowUpdateRequest, c)
+	err := mailcowUpdate(ctx, resourceSyncjob(), d, &syncjobNotSent, nil, mailcowUpdateRequest, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
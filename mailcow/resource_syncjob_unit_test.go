@@ -0,0 +1,150 @@
+package mailcow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSyncjobMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceSyncjob()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"username":  "demo@440044.xyz",
+		"host1":     "example.com",
+		"user1":     "demo@example.com",
+		"password1": "secret-password",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":      "1",
+		"delete2": "false",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	if requests := mock.requested("get/syncjobs/all/no_log"); requests != 0 {
+		t.Errorf("expected no listing of all sync jobs with a known username, got %d", requests)
+	}
+	testMockCheckAttrs(t, state, map[string]string{
+		"username":      "demo@440044.xyz",
+		"host1":         "example.com",
+		"mins_interval": "20",
+		"active":        "true",
+		"delete2":       "false",
+		"last_run":      "",
+		"success":       "false",
+		"is_running":    "false",
+		"log":           "",
+		"keep_log":      "true",
+	})
+
+	// mailcow updates the status when imapsync runs the sync job
+	object := mock.get("syncjob", state.ID)
+	object["last_run"] = "2026-10-18 10:00:42"
+	object["success"] = 1
+	object["exit_status"] = "EXIT_OK"
+	object["is_running"] = 1
+	object["log"] = mockImapsyncLog
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"last_run":    "2026-10-18 10:00:42",
+		"success":     "true",
+		"exit_status": "EXIT_OK",
+		"is_running":  "true",
+		"log":         mockImapsyncLog,
+	})
+
+	// the log can be kept out of the state
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"username":  "demo@440044.xyz",
+		"host1":     "example.com",
+		"user1":     "demo@example.com",
+		"password1": "secret-password",
+		"keep_log":  false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"keep_log":    "false",
+		"log":         "",
+		"exit_status": "EXIT_OK",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"username":          "demo@440044.xyz",
+		"host1":             "update-example.com",
+		"user1":             "demo@example.com",
+		"password1":         "secret-password",
+		"mins_interval":     42,
+		"maxbytespersecond": "42",
+		"delete2":           true,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"host1":             "update-example.com",
+		"mins_interval":     "42",
+		"maxbytespersecond": "42",
+		"delete2":           "true",
+	})
+
+	for _, importId := range []string{state.ID, "demo@440044.xyz/" + state.ID} {
+		listings := mock.requested("get/syncjobs/all/no_log")
+		imported := testMockImport(t, res, importId, meta)
+		if requests := mock.requested("get/syncjobs/all/no_log") - listings; requests != 1 {
+			t.Errorf("expected one listing of all sync jobs on import, got %d", requests)
+		}
+		testMockCheckAttrs(t, imported, map[string]string{
+			"keep_log": "true",
+			"log":      mockImapsyncLog,
+		})
+		testMockCheckAttrs(t, imported, map[string]string{
+			"id":            state.ID,
+			"username":      "demo@440044.xyz",
+			"host1":         "update-example.com",
+			"user1":         "demo@example.com",
+			"mins_interval": "42",
+			"active":        "true",
+			"delete2":       "true",
+		})
+	}
+
+	_, err := res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "other@440044.xyz/" + state.ID}), meta)
+	if err == nil {
+		t.Error("expected an error importing a syncjob of another mailbox")
+	}
+
+	imported := testMockImport(t, res, "demo@440044.xyz:update-example.com:demo@example.com", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"id":       state.ID,
+		"username": "demo@440044.xyz",
+		"host1":    "update-example.com",
+		"user1":    "demo@example.com",
+		"delete2":  "true",
+	})
+
+	_, err = res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "demo@440044.xyz:example.com:demo@example.com"}), meta)
+	if err == nil {
+		t.Error("expected an error importing an unknown syncjob")
+	}
+
+	mock.put("syncjob", "42", map[string]interface{}{"id": 42, "user2": "demo@440044.xyz", "host1": "update-example.com", "user1": "demo@example.com"})
+	_, err = res.Importer.StateContext(t.Context(), res.Data(&terraform.InstanceState{ID: "demo@440044.xyz:update-example.com:demo@example.com"}), meta)
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an error importing an ambiguous syncjob, got %v", err)
+	}
+	mock.remove("syncjob", "42")
+
+	// without a username in the state read falls back to the listing of all sync jobs
+	withoutUsername := state.DeepCopy()
+	withoutUsername.Attributes["username"] = ""
+	refreshed := testMockRefresh(t, res, withoutUsername, meta)
+	testMockCheckAttrs(t, refreshed, map[string]string{
+		"username": "demo@440044.xyz",
+		"host1":    "update-example.com",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("syncjob", state.ID) != nil {
+		t.Fatal("syncjob not deleted")
+	}
+}
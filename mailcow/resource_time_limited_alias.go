@@ -0,0 +1,167 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+func resourceTimeLimitedAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTimeLimitedAliasCreate,
+		ReadContext:   resourceTimeLimitedAliasRead,
+		DeleteContext: resourceTimeLimitedAliasDelete,
+
+		Schema: map[string]*schema.Schema{
+			"mailbox": {
+				Type:        schema.TypeString,
+				Description: "e-mail address of the mailbox the alias delivers to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"validity": {
+				Type:         schema.TypeInt,
+				Description:  "hours the alias is valid, an expired alias is re-created",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "domain of the generated address, the domain of the mailbox if not set",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "description of the alias",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "the generated e-mail address of the alias",
+				Computed:    true,
+			},
+			"expires": {
+				Type:        schema.TypeString,
+				Description: "expiry of the alias (RFC 3339)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceTimeLimitedAliasCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailbox := d.Get("mailbox").(string)
+	domain := d.Get("domain").(string)
+	if domain == "" {
+		_, domain, _ = strings.Cut(mailbox, "@")
+	}
+
+	// mailcow generates the address and does not return it, the new alias is the one not known before
+	known, err := readTimeLimitedAliases(ctx, c, mailbox)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mailcowCreateRequest := api.NewCreateTimeLimitedAliasRequest()
+	mailcowCreateRequest.Set("username", mailbox)
+	mailcowCreateRequest.Set("domain", domain)
+
+	exclude := []string{"mailbox", "domain", "address", "expires"}
+	err = mailcowCreate(ctx, resourceTimeLimitedAlias(), d, mailbox, &exclude, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeLimitedAliases, err := readTimeLimitedAliases(ctx, c, mailbox)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for address := range timeLimitedAliases {
+		if _, ok := known[address]; !ok && strings.HasSuffix(address, "@"+domain) {
+			d.SetId(address)
+			return resourceTimeLimitedAliasRead(ctx, d, m)
+		}
+	}
+	return diag.Errorf("time-limited alias of mailbox %s not found", mailbox)
+}
+
+// readTimeLimitedAliases returns the time-limited aliases of the mailbox by address
+func readTimeLimitedAliases(ctx context.Context, c *APIClient, mailbox string) (map[string]map[string]interface{}, error) {
+	timeLimitedAliases, err := readAllRequest(c.client.Api.MailcowGetTimeLimitedAliases(ctx, mailbox))
+	if err != nil {
+		return nil, err
+	}
+	byAddress := make(map[string]map[string]interface{})
+	for _, timeLimitedAlias := range timeLimitedAliases {
+		byAddress[fmt.Sprint(timeLimitedAlias["address"])] = timeLimitedAlias
+	}
+	return byAddress, nil
+}
+
+func resourceTimeLimitedAliasRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+	mailbox := d.Get("mailbox").(string)
+
+	timeLimitedAliases, err := readTimeLimitedAliases(ctx, c, mailbox)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeLimitedAlias, ok := timeLimitedAliases[id]
+	if !ok {
+		return removeFromState(d, "time-limited alias")
+	}
+
+	validity, err := strconv.ParseFloat(fmt.Sprint(timeLimitedAlias["validity"]), 64)
+	if err != nil {
+		return diag.Errorf("unexpected validity of time-limited alias %s: %v", id, timeLimitedAlias["validity"])
+	}
+	expires := time.Unix(int64(validity), 0).UTC()
+	if !expires.After(time.Now()) {
+		// mailcow keeps expired aliases until they are cleaned up, they are deleted so that they do not pile up on every re-creation
+		log.Printf("[WARN] time-limited alias '%s' expired at %s, deleting it", id, expires.Format(time.RFC3339))
+		diags, _ = mailcowDelete(ctx, d, api.NewDeleteTimeLimitedAliasRequest(), c)
+		return diags
+	}
+
+	_, domain, _ := strings.Cut(id, "@")
+	timeLimitedAlias["domain"] = domain
+	timeLimitedAlias["expires"] = expires.Format(time.RFC3339)
+	exclude := []string{"mailbox", "validity"}
+	// older mailcow versions do not know descriptions of time-limited aliases
+	if timeLimitedAlias["description"] == nil {
+		exclude = append(exclude, "description")
+	}
+	err = setResourceData(resourceTimeLimitedAlias(), d, &timeLimitedAlias, &exclude, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceTimeLimitedAliasDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteTimeLimitedAliasRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
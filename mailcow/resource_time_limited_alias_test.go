@@ -0,0 +1,57 @@
+package mailcow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTimeLimitedAlias(t *testing.T) {
+	domain := fmt.Sprintf("with-tla-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTimeLimitedAlias(domain, 24),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_time_limited_alias.alias", "mailbox", "demo@"+domain),
+					resource.TestCheckResourceAttr("mailcow_time_limited_alias.alias", "domain", domain),
+					resource.TestCheckResourceAttr("mailcow_time_limited_alias.alias", "validity", "24"),
+					resource.TestMatchResourceAttr("mailcow_time_limited_alias.alias", "address", regexp.MustCompile("@"+regexp.QuoteMeta(domain)+"$")),
+					resource.TestCheckResourceAttrSet("mailcow_time_limited_alias.alias", "expires"),
+				),
+			},
+			{
+				Config: testAccResourceTimeLimitedAlias(domain, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_time_limited_alias.alias", "validity", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceTimeLimitedAlias(domain string, validity int) string {
+	return fmt.Sprintf(`
+resource "mailcow_domain" "domain" {
+  domain = "%[1]s"
+}
+
+resource "mailcow_mailbox" "mailbox" {
+  domain     = mailcow_domain.domain.domain
+  local_part = "demo"
+  full_name  = "Demo User"
+  password   = "secret-password"
+}
+
+resource "mailcow_time_limited_alias" "alias" {
+  mailbox     = mailcow_mailbox.mailbox.address
+  validity    = %[2]d
+  description = "terraform"
+}
+`, domain, validity)
+}
@@ -0,0 +1,66 @@
+package mailcow
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResourceTimeLimitedAliasMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceTimeLimitedAlias()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"mailbox":     "demo@440044.xyz",
+		"validity":    24,
+		"description": "newsletter",
+	}, meta)
+	address := state.ID
+	if !strings.HasSuffix(address, "@440044.xyz") {
+		t.Fatalf("unexpected address %s", address)
+	}
+	testMockCheckAttrs(t, state, map[string]string{
+		"address":     address,
+		"domain":      "440044.xyz",
+		"description": "newsletter",
+		"validity":    "24",
+	})
+	expires, err := time.Parse(time.RFC3339, state.Attributes["expires"])
+	if err != nil {
+		t.Fatalf("unexpected expires %q: %v", state.Attributes["expires"], err)
+	}
+	if expires.Before(time.Now().Add(23*time.Hour)) || expires.After(time.Now().Add(25*time.Hour)) {
+		t.Fatalf("unexpected expires %s", expires)
+	}
+
+	// another alias of the mailbox on another domain is not mixed up
+	other := testMockApply(t, res, nil, map[string]interface{}{
+		"mailbox":  "demo@440044.xyz",
+		"validity": 1,
+		"domain":   "alias.xyz",
+	}, meta)
+	if !strings.HasSuffix(other.ID, "@alias.xyz") {
+		t.Fatalf("unexpected address %s", other.ID)
+	}
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"address": address,
+	})
+
+	// an expired alias is drift
+	mock.get("time_limited_alias", address)["validity"] = time.Now().Add(-time.Minute).Unix()
+	expired := testMockRefresh(t, res, state, meta)
+	if expired != nil {
+		t.Fatalf("expected expired alias to be removed from state, got id %q", expired.ID)
+	}
+	if mock.get("time_limited_alias", address) != nil {
+		t.Fatal("expired time-limited alias not deleted")
+	}
+
+	testMockDestroy(t, res, other, meta)
+	if mock.get("time_limited_alias", other.ID) != nil {
+		t.Fatal("time-limited alias not deleted")
+	}
+}
@@ -0,0 +1,201 @@
+package mailcow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+// tlsPolicies are the postfix TLS security levels mailcow accepts
+var tlsPolicies = []string{
+	"none",
+	"may",
+	"encrypt",
+	"dane",
+	"dane-only",
+	"fingerprint",
+	"verify",
+	"secure",
+}
+
+// tlsPolicyParameters are the postfix attributes allowed in the parameters of a TLS policy
+var tlsPolicyParameters = []string{
+	"ciphers",
+	"connection_reuse",
+	"enable_rpk",
+	"exclude",
+	"match",
+	"protocols",
+	"servername",
+	"tafile",
+}
+
+func resourceTlsPolicyMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTlsPolicyMapCreate,
+		ReadContext:   resourceTlsPolicyMapRead,
+		UpdateContext: resourceTlsPolicyMapUpdate,
+		DeleteContext: resourceTlsPolicyMapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTlsPolicyMapImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dest": {
+				Type:        schema.TypeString,
+				Description: "the destination domain or address the TLS policy applies to",
+				Required:    true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Description:  "the TLS policy enforced for the destination. One of: " + strings.Join(tlsPolicies, ", ") + ".",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(tlsPolicies, false),
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Description:      "space separated postfix policy attributes name=value, e.g. \"protocols=>=TLSv1.2 match=.example.org\". Allowed names: " + strings.Join(tlsPolicyParameters, ", ") + ".",
+				Optional:         true,
+				ValidateDiagFunc: validateTlsPolicyParametersDiag,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is TLS policy map active or not",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func validateTlsPolicyParametersDiag(v any, _ cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, parameter := range strings.Fields(v.(string)) {
+		name, value, ok := strings.Cut(parameter, "=")
+		if !ok || value == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Invalid TLS policy parameter '%s'", parameter),
+				Detail:   "Every parameter must have the form name=value.",
+			})
+			continue
+		}
+		if !slices.Contains(tlsPolicyParameters, name) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unknown TLS policy parameter '%s'", name),
+				Detail:   fmt.Sprintf("The parameter name must be one of: %s.", strings.Join(tlsPolicyParameters, ", ")),
+			})
+		}
+	}
+	return diags
+}
+
+// resourceTlsPolicyMapImport accepts the id or the destination
+func resourceTlsPolicyMapImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	c := m.(*APIClient)
+	id, err := getTlsPolicyMapId(ctx, c.client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(*id)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTlsPolicyMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	dest := d.Get("dest").(string)
+
+	mailcowCreateRequest := api.NewCreateTlsPolicyMapRequest()
+
+	err := mailcowCreate(ctx, resourceTlsPolicyMap(), d, dest, nil, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new TLS policy map, but the destination is unique
+	id, err := getTlsPolicyMapId(ctx, c.client, dest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*id)
+
+	return resourceTlsPolicyMapRead(ctx, d, m)
+}
+
+func getTlsPolicyMapId(ctx context.Context, client *api.APIClient, dest string) (*string, error) {
+	request := client.Api.MailcowGetTlsPolicyMaps(ctx)
+
+	tlsPolicyMaps, err := readAllRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tlsPolicyMap := range tlsPolicyMaps {
+		if tlsPolicyMap["dest"] == dest {
+			id := fmt.Sprint(tlsPolicyMap["id"])
+			return &id, nil
+		}
+	}
+	return nil, fmt.Errorf("TLS policy map not found: %s", dest)
+}
+
+func resourceTlsPolicyMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request := c.client.Api.MailcowGetTlsPolicyMap(ctx, id)
+
+	tlsPolicyMap, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tlsPolicyMap["id"] == nil {
+		return removeFromState(d, "TLS policy map")
+	}
+
+	err = setResourceData(resourceTlsPolicyMap(), d, &tlsPolicyMap, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceTlsPolicyMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateTlsPolicyMapRequest()
+
+	err := mailcowUpdate(ctx, resourceTlsPolicyMap(), d, nil, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTlsPolicyMapRead(ctx, d, m)
+}
+
+func resourceTlsPolicyMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteTlsPolicyMapRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
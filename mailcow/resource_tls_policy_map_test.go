@@ -0,0 +1,67 @@
+package mailcow
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTlsPolicyMap(t *testing.T) {
+	dest := fmt.Sprintf("with-tls-policy-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceTlsPolicyMap(dest, "strict", "", "true"),
+				ExpectError: regexp.MustCompile(`expected policy to be one of`),
+			},
+			{
+				Config:      testAccResourceTlsPolicyMap(dest, "secure", "cipher=high", "true"),
+				ExpectError: regexp.MustCompile(`Unknown TLS policy parameter`),
+			},
+			{
+				Config: testAccResourceTlsPolicyMap(dest, "secure", "match=."+dest, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "dest", dest),
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "policy", "secure"),
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "parameters", "match=."+dest),
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceTlsPolicyMap(dest, "verify", "", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "policy", "verify"),
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "parameters", ""),
+					resource.TestCheckResourceAttr("mailcow_tls_policy_map.tls_policy_map", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_tls_policy_map.tls_policy_map",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "mailcow_tls_policy_map.tls_policy_map",
+				ImportState:       true,
+				ImportStateId:     dest,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTlsPolicyMap(dest string, policy string, parameters string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_tls_policy_map" "tls_policy_map" {
+  dest       = "%[1]s"
+  policy     = "%[2]s"
+  parameters = "%[3]s"
+  active     = %[4]s
+}
+`, dest, policy, parameters, active)
+}
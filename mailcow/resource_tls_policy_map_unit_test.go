@@ -0,0 +1,84 @@
+package mailcow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceTlsPolicyMapMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceTlsPolicyMap()
+
+	testMockApply(t, res, nil, map[string]interface{}{
+		"dest":   "other.xyz",
+		"policy": "dane",
+	}, meta)
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"dest":       "partner.xyz",
+		"policy":     "secure",
+		"parameters": "match=.partner.xyz protocols=>=TLSv1.2",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":     "2",
+		"policy": "secure",
+		"active": "true",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"dest":       "partner.xyz",
+		"parameters": "match=.partner.xyz protocols=>=TLSv1.2",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"dest":   "partner.xyz",
+		"policy": "verify",
+		"active": false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":         "2",
+		"policy":     "verify",
+		"parameters": "",
+		"active":     "false",
+	})
+
+	imported := testMockImport(t, res, "partner.xyz", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"id":     "2",
+		"dest":   "partner.xyz",
+		"policy": "verify",
+		"active": "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("tls-policy-map", "2") != nil {
+		t.Fatal("TLS policy map not deleted")
+	}
+}
+
+func TestResourceTlsPolicyMapValidation(t *testing.T) {
+	res := resourceTlsPolicyMap()
+
+	for _, raw := range []map[string]interface{}{
+		{"dest": "partner.xyz", "policy": "strict"},
+		{"dest": "partner.xyz", "policy": "secure", "parameters": "match"},
+		{"dest": "partner.xyz", "policy": "secure", "parameters": "protocols="},
+		{"dest": "partner.xyz", "policy": "secure", "parameters": "cipher=high"},
+	} {
+		if diags := res.Validate(terraform.NewResourceConfigRaw(raw)); !diags.HasError() {
+			t.Errorf("expected %v to be invalid", raw)
+		}
+	}
+	for _, raw := range []map[string]interface{}{
+		{"dest": "partner.xyz", "policy": "dane-only"},
+		{"dest": "partner.xyz", "policy": "secure", "parameters": ""},
+		{"dest": "partner.xyz", "policy": "secure", "parameters": "match=.partner.xyz:partner.xyz  ciphers=high"},
+	} {
+		if diags := res.Validate(terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+			t.Errorf("expected %v to be valid: %v", raw, diags)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package mailcow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+func resourceTransport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTransportCreate,
+		ReadContext:   resourceTransportRead,
+		UpdateContext: resourceTransportUpdate,
+		DeleteContext: resourceTransportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTransportImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination": {
+				Type:        schema.TypeString,
+				Description: "the recipient domain or address the transport map applies to, \"*\" for all",
+				Required:    true,
+			},
+			"nexthop": {
+				Type:        schema.TypeString,
+				Description: "the next hop the mails are relayed to, e.g. \"[smtp.example.org]:587\"",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "username for the authentication at the next hop",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "password for the authentication at the next hop",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "is transport map active or not",
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceTransportImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTransportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	destination := d.Get("destination").(string)
+	nexthop := d.Get("nexthop").(string)
+
+	mailcowCreateRequest := api.NewCreateTransportRequest()
+
+	err := mailcowCreate(ctx, resourceTransport(), d, destination, nil, nil, mailcowCreateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// mailcow does not return the id of the new transport map, the newest one with the destination and nexthop is it
+	transports, err := readAllRequest(c.client.Api.MailcowGetTransports(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, ok := newestId(transports, func(transport map[string]interface{}) bool {
+		return transport["destination"] == destination && transport["nexthop"] == nexthop
+	})
+	if !ok {
+		return diag.Errorf("transport map %s not found", destination)
+	}
+	d.SetId(id)
+
+	return resourceTransportRead(ctx, d, m)
+}
+
+func resourceTransportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*APIClient)
+	id := d.Id()
+
+	request := c.client.Api.MailcowGetTransport(ctx, id)
+
+	transport, err := readRequest(request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if transport["id"] == nil {
+		return removeFromState(d, "transport map")
+	}
+
+	err = setResourceData(resourceTransport(), d, &transport, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceTransportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+
+	mailcowUpdateRequest := api.NewUpdateTransportRequest()
+
+	err := mailcowUpdate(ctx, resourceTransport(), d, nil, nil, mailcowUpdateRequest, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTransportRead(ctx, d, m)
+}
+
+func resourceTransportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*APIClient)
+	mailcowDeleteRequest := api.NewDeleteTransportRequest()
+	diags, _ := mailcowDelete(ctx, d, mailcowDeleteRequest, c)
+	return diags
+}
@@ -0,0 +1,52 @@
+package mailcow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTransport(t *testing.T) {
+	destination := fmt.Sprintf("with-transport-%s.domain-%s.xyz", randomLowerCaseString(4), randomLowerCaseString(4))
+	nexthop := fmt.Sprintf("[smtp-%s.example.org]:587", randomLowerCaseString(4))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTransport(destination, nexthop, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_transport.transport", "destination", destination),
+					resource.TestCheckResourceAttr("mailcow_transport.transport", "nexthop", nexthop),
+					resource.TestCheckResourceAttr("mailcow_transport.transport", "username", "relay"),
+					resource.TestCheckResourceAttr("mailcow_transport.transport", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceTransport(destination, nexthop, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailcow_transport.transport", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "mailcow_transport.transport",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTransport(destination string, nexthop string, active string) string {
+	return fmt.Sprintf(`
+resource "mailcow_transport" "transport" {
+  destination = "%[1]s"
+  nexthop     = "%[2]s"
+  username    = "relay"
+  password    = "secret-password"
+  active      = %[3]s
+}
+`, destination, nexthop, active)
+}
@@ -0,0 +1,54 @@
+package mailcow
+
+import (
+	"testing"
+)
+
+func TestResourceTransportMock(t *testing.T) {
+	mock := newMockMailcow(t)
+	meta := mock.meta()
+	res := resourceTransport()
+
+	state := testMockApply(t, res, nil, map[string]interface{}{
+		"destination": "440044.xyz",
+		"nexthop":     "[smtp.440044.xyz]:587",
+		"username":    "relay",
+		"password":    "secret",
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":     "1",
+		"active": "true",
+	})
+
+	state = testMockRefresh(t, res, state, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"destination": "440044.xyz",
+		"nexthop":     "[smtp.440044.xyz]:587",
+		"username":    "relay",
+	})
+
+	state = testMockApply(t, res, state, map[string]interface{}{
+		"destination": "*",
+		"nexthop":     "[smtp.440044.xyz]:587",
+		"username":    "relay",
+		"password":    "secret",
+		"active":      false,
+	}, meta)
+	testMockCheckAttrs(t, state, map[string]string{
+		"id":          "1",
+		"destination": "*",
+		"active":      "false",
+	})
+
+	imported := testMockImport(t, res, "1", meta)
+	testMockCheckAttrs(t, imported, map[string]string{
+		"destination": "*",
+		"nexthop":     "[smtp.440044.xyz]:587",
+		"active":      "false",
+	})
+
+	testMockDestroy(t, res, state, meta)
+	if mock.get("transport", "1") != nil {
+		t.Fatal("transport map not deleted")
+	}
+}
@@ -0,0 +1,287 @@
+package mailcow
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// sieve token kinds
+const (
+	sieveIdentifier = iota
+	sieveTag
+	sieveNumber
+	sieveString
+	sieveSpecial
+	sieveEnd
+)
+
+type sieveToken struct {
+	kind  int
+	value string
+	line  int
+}
+
+// checkSieveSyntax checks the syntax of a sieve script (RFC 5228), it does not check the commands, tests and extensions
+func checkSieveSyntax(script string) error {
+	tokens, err := sieveTokenize(script)
+	if err != nil {
+		return err
+	}
+	parser := sieveParser{tokens: tokens}
+	for parser.peek().kind != sieveEnd {
+		err = parser.command()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sieveTokenize(script string) ([]sieveToken, error) {
+	script = strings.ReplaceAll(script, "\r\n", "\n")
+	tokens := make([]sieveToken, 0)
+	line := 1
+	for i := 0; i < len(script); {
+		c := script[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t':
+			i++
+		case c == '#':
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(script[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"':
+			start := line
+			j := i + 1
+			for ; j < len(script) && script[j] != '"'; j++ {
+				if script[j] == '\\' {
+					j++
+				}
+				if j < len(script) && script[j] == '\n' {
+					line++
+				}
+			}
+			if j >= len(script) {
+				return nil, fmt.Errorf("line %d: unterminated string", start)
+			}
+			tokens = append(tokens, sieveToken{kind: sieveString, value: script[i+1 : j], line: start})
+			i = j + 1
+		case strings.HasPrefix(script[i:], "text:"):
+			start := line
+			// the multi-line string starts after the end of the line and ends with a line holding a single dot
+			eol := strings.IndexByte(script[i:], '\n')
+			if eol < 0 {
+				return nil, fmt.Errorf("line %d: unterminated multi-line string", start)
+			}
+			rest := strings.TrimLeft(script[i+5:i+eol], " \t")
+			if rest != "" && !strings.HasPrefix(rest, "#") {
+				return nil, fmt.Errorf("line %d: unexpected %q after text:", start, rest)
+			}
+			body := script[i+eol+1:]
+			end := -1
+			if strings.HasPrefix(body, ".\n") || body == "." {
+				end = 0
+			} else if index := strings.Index(body, "\n.\n"); index >= 0 {
+				end = index + 1
+			} else if strings.HasSuffix(body, "\n.") {
+				end = len(body) - 1
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated multi-line string", start)
+			}
+			line += 1 + strings.Count(body[:end], "\n") + 1
+			tokens = append(tokens, sieveToken{kind: sieveString, value: body[:end], line: start})
+			i += eol + 1 + end + 2
+		case c == ':':
+			j := i + 1
+			for j < len(script) && isSieveIdentifierChar(script[j], j == i+1) {
+				j++
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("line %d: tag without name", line)
+			}
+			tokens = append(tokens, sieveToken{kind: sieveTag, value: script[i+1 : j], line: line})
+			i = j
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(script) && script[j] >= '0' && script[j] <= '9' {
+				j++
+			}
+			if j < len(script) && strings.ContainsRune("KkMmGg", rune(script[j])) {
+				j++
+			}
+			tokens = append(tokens, sieveToken{kind: sieveNumber, value: script[i:j], line: line})
+			i = j
+		case isSieveIdentifierChar(c, true):
+			j := i
+			for j < len(script) && isSieveIdentifierChar(script[j], j == i) {
+				j++
+			}
+			tokens = append(tokens, sieveToken{kind: sieveIdentifier, value: script[i:j], line: line})
+			i = j
+		case strings.IndexByte("[](){},;", c) >= 0:
+			tokens = append(tokens, sieveToken{kind: sieveSpecial, value: string(c), line: line})
+			i++
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return append(tokens, sieveToken{kind: sieveEnd, value: "end of script", line: line}), nil
+}
+
+func isSieveIdentifierChar(c byte, first bool) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (!first && c >= '0' && c <= '9')
+}
+
+type sieveParser struct {
+	tokens []sieveToken
+	pos    int
+}
+
+func (p *sieveParser) peek() sieveToken {
+	return p.tokens[p.pos]
+}
+
+func (p *sieveParser) next() sieveToken {
+	token := p.tokens[p.pos]
+	if token.kind != sieveEnd {
+		p.pos++
+	}
+	return token
+}
+
+func (p *sieveParser) isSpecial(value string) bool {
+	token := p.peek()
+	return token.kind == sieveSpecial && token.value == value
+}
+
+func (p *sieveParser) expectSpecial(value string) error {
+	token := p.next()
+	if token.kind != sieveSpecial || token.value != value {
+		return fmt.Errorf("line %d: expected %q, got %q", token.line, value, token.value)
+	}
+	return nil
+}
+
+// command = identifier arguments ( ";" / block )
+func (p *sieveParser) command() error {
+	token := p.next()
+	if token.kind != sieveIdentifier {
+		return fmt.Errorf("line %d: expected command, got %q", token.line, token.value)
+	}
+	err := p.arguments()
+	if err != nil {
+		return err
+	}
+	if p.isSpecial("{") {
+		p.next()
+		for !p.isSpecial("}") {
+			if p.peek().kind == sieveEnd {
+				return fmt.Errorf("line %d: block of %s not closed", token.line, token.value)
+			}
+			err = p.command()
+			if err != nil {
+				return err
+			}
+		}
+		p.next()
+		return nil
+	}
+	return p.expectSpecial(";")
+}
+
+// arguments = *argument [ test / test-list ]
+func (p *sieveParser) arguments() error {
+	for {
+		token := p.peek()
+		switch {
+		case token.kind == sieveTag || token.kind == sieveNumber || token.kind == sieveString:
+			p.next()
+		case p.isSpecial("["):
+			err := p.stringList()
+			if err != nil {
+				return err
+			}
+		case token.kind == sieveIdentifier:
+			return p.test()
+		case p.isSpecial("("):
+			return p.testList()
+		default:
+			return nil
+		}
+	}
+}
+
+// string-list = "[" string *( "," string ) "]"
+func (p *sieveParser) stringList() error {
+	p.next()
+	for {
+		token := p.next()
+		if token.kind != sieveString {
+			return fmt.Errorf("line %d: expected string in string list, got %q", token.line, token.value)
+		}
+		if p.isSpecial("]") {
+			p.next()
+			return nil
+		}
+		err := p.expectSpecial(",")
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// test = identifier arguments
+func (p *sieveParser) test() error {
+	p.next()
+	return p.arguments()
+}
+
+// test-list = "(" test *( "," test ) ")"
+func (p *sieveParser) testList() error {
+	p.next()
+	for {
+		token := p.peek()
+		if token.kind != sieveIdentifier {
+			return fmt.Errorf("line %d: expected test, got %q", token.line, token.value)
+		}
+		err := p.test()
+		if err != nil {
+			return err
+		}
+		if p.isSpecial(")") {
+			p.next()
+			return nil
+		}
+		err = p.expectSpecial(",")
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func validateSieveScriptDiag(v any, _ cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	err := checkSieveSyntax(v.(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid sieve script",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
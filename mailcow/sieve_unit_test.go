@@ -0,0 +1,55 @@
+package mailcow
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckSieveSyntax(t *testing.T) {
+	valid := []string{
+		"",
+		"keep;",
+		`require ["fileinto", "reject"];
+# move spam
+if header :contains "X-Spam-Flag" "YES" {
+  fileinto "Junk";
+  stop;
+} elsif allof (address :domain :is "from" "440044.xyz", not exists "list-id") {
+  keep;
+} else {
+  redirect "demo@440044.xyz";
+}`,
+		`/* size */ if size :over 10M { discard; }`,
+		"require \"vacation\";\r\nvacation :days 7 :subject \"away\" text:\r\nI am away.\r\n..dot-stuffed\r\n.\r\n;",
+		`if header :matches "subject" ["*money*", "*\"win\"*"] { reject "no"; }`,
+	}
+	for _, script := range valid {
+		if err := checkSieveSyntax(script); err != nil {
+			t.Errorf("expected valid script %q: %v", script, err)
+		}
+	}
+
+	invalid := map[string]string{
+		`keep`:                 "line 1: expected \";\"",
+		`fileinto "Junk;`:      "line 1: unterminated string",
+		"if true {\n  keep;\n": "line 1: block of if not closed",
+		"if header :is \"a\" [\"b\" \"c\"] { keep; }":  "line 1: expected \",\"",
+		"if anyof (true; false) { keep; }":             "line 1: expected \",\"",
+		"\n\nfileinto \"Junk\"; }":                     "line 3: expected command",
+		"/* unterminated":                              "line 1: unterminated comment",
+		"vacation text:\nI am away.\n":                 "line 1: unterminated multi-line string",
+		"if size :over 10M { discard; } @":             "line 1: unexpected character",
+		"if header : \"subject\" \"x\" { discard; }":   "line 1: tag without name",
+		"keep;\nif allof (header :is \"a\" \"b\",) {}": "line 2: expected test",
+	}
+	for script, expected := range invalid {
+		err := checkSieveSyntax(script)
+		if err == nil {
+			t.Errorf("expected invalid script %q", script)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("script %q: expected error %q, got %q", script, expected, err)
+		}
+	}
+}
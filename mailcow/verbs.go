@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,37 +36,79 @@ func createRequestSet(mailcowCreateRequest *api.MailcowCreateRequest, res *schem
 			continue
 		}
 		value := data.Get(argument)
-		log.Print("[TRACE] createRequestSet set argument: ", getMappedArgument(argument, mapArguments), " := ", value)
+		log.Print("[TRACE] createRequestSet set argument: ", getMappedArgument(argument, mapArguments), " := ", logValue(res.Schema[argument], value))
 		mailcowCreateRequest.Set(getMappedArgument(argument, mapArguments), value)
 	}
 }
 
+// readRequest returns the requested object, an empty map if mailcow does not know it
 func readRequest(request api.ApiMailcowGetRequest) (map[string]interface{}, error) {
 	response, err := request.MailcowExecute()
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return make(map[string]interface{}), nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	result := make(map[string]interface{}, 0)
-	err = json.NewDecoder(response.Body).Decode(&result)
-	if err != nil {
+	var decoded interface{}
+	err = json.NewDecoder(response.Body).Decode(&decoded)
+	if err != nil && err != io.EOF {
 		return nil, err
 	}
-	return result, nil
+	switch result := decoded.(type) {
+	case map[string]interface{}:
+		return result, nil
+	case []interface{}:
+		// mailcow answers with an empty array instead of an empty object for some unknown objects
+		if len(result) == 0 {
+			return make(map[string]interface{}), nil
+		}
+	case nil:
+		return make(map[string]interface{}), nil
+	}
+	return nil, fmt.Errorf("unexpected response: %v", decoded)
 }
 
+// readAllRequest returns the requested objects, an empty list if mailcow does not know any
 func readAllRequest(request api.ApiMailcowGetAllRequest) ([]map[string]interface{}, error) {
 	response, err := request.ApiService.MailcowGetAllExecute(request)
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return make([]map[string]interface{}, 0), nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	result := make([]map[string]interface{}, 0)
-	err = json.NewDecoder(response.Body).Decode(&result)
-	if err != nil {
+	var decoded interface{}
+	err = json.NewDecoder(response.Body).Decode(&decoded)
+	if err != nil && err != io.EOF {
 		return nil, err
 	}
+	result := make([]map[string]interface{}, 0)
+	switch items := decoded.(type) {
+	case []interface{}:
+		for _, item := range items {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unexpected response item: %v", item)
+			}
+			result = append(result, object)
+		}
+	case map[string]interface{}:
+		// mailcow answers with an empty object instead of an empty array if there are no objects
+		if len(items) != 0 {
+			return nil, fmt.Errorf("unexpected response: %v", decoded)
+		}
+	}
 	return result, nil
 }
 
+// removeFromState drops a resource deleted outside of terraform from the state, so that it is planned to be re-created
+func removeFromState(d *schema.ResourceData, resourceName string) diag.Diagnostics {
+	log.Printf("[WARN] %s '%s' not found, removing it from state", resourceName, d.Id())
+	d.SetId("")
+	return nil
+}
+
 func updateRequestSetAttr(mailcowUpdateRequest *api.MailcowUpdateRequest, res *schema.Resource, data *schema.ResourceData, exclude *[]string, mapArguments *map[string]string) {
 	for argument := range (*res).Schema {
 		log.Print("[TRACE] updateRequestSetAttr argument: ", argument)
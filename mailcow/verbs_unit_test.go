@@ -0,0 +1,204 @@
+package mailcow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/l-with/terraform-provider-mailcow/api"
+)
+
+func TestReadRequestNotFound(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		expected   int
+	}{
+		{name: "object", statusCode: http.StatusOK, body: `{"id": 1}`, expected: 1},
+		{name: "empty object", statusCode: http.StatusOK, body: `{}`, expected: 0},
+		{name: "empty array", statusCode: http.StatusOK, body: `[]`, expected: 0},
+		{name: "null", statusCode: http.StatusOK, body: `null`, expected: 0},
+		{name: "not found", statusCode: http.StatusNotFound, body: `{"type": "error", "msg": "not found"}`, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			serverUrl, _ := url.Parse(server.URL)
+			config := api.NewConfiguration()
+			config.Host = serverUrl.Host
+			config.Scheme = serverUrl.Scheme
+			client := api.NewAPIClient(config)
+
+			result, err := readRequest(client.Api.MailcowGetAlias(context.Background(), "1"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(result) != tc.expected {
+				t.Errorf("expected %d keys, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestResourceReadVanishedMock(t *testing.T) {
+	testCases := []struct {
+		name   string
+		res    *schema.Resource
+		kind   string
+		id     string
+		config map[string]interface{}
+	}{
+		{
+			name:   "alias",
+			res:    resourceAlias(),
+			kind:   "alias",
+			config: map[string]interface{}{"address": "alias@440044.xyz", "goto": "demo@440044.xyz"},
+		},
+		{
+			name:   "app password",
+			res:    resourceAppPassword(),
+			kind:   "app-passwd",
+			config: map[string]interface{}{"mailbox": "demo@440044.xyz", "app_name": "ci", "protocols": []interface{}{"imap"}},
+		},
+		{
+			name:   "bcc map",
+			res:    resourceBccMap(),
+			kind:   "bcc",
+			config: map[string]interface{}{"local_dest": "440044.xyz", "bcc_dest": "archive@440044.xyz", "type": "sender"},
+		},
+		{
+			name:   "domain",
+			res:    resourceDomain(),
+			kind:   "domain",
+			config: map[string]interface{}{"domain": "440044.xyz"},
+		},
+		{
+			name:   "domain alias",
+			res:    resourceDomainAlias(),
+			kind:   "alias-domain",
+			config: map[string]interface{}{"alias_domain": "alias.xyz", "target_domain": "440044.xyz"},
+		},
+		{
+			name:   "domain admin",
+			res:    resourceDomainAdmin(),
+			kind:   "domain-admin",
+			config: map[string]interface{}{"username": "demoadmin", "password": "secret", "domains": []interface{}{"440044.xyz"}},
+		},
+		{
+			name:   "mailbox",
+			res:    resourceMailbox(),
+			kind:   "mailbox",
+			config: map[string]interface{}{"domain": "440044.xyz", "local_part": "demo", "full_name": "Demo", "password": "secret"},
+		},
+		{
+			name:   "recipient map",
+			res:    resourceRecipientMap(),
+			kind:   "recipient_map",
+			config: map[string]interface{}{"recipient_map_old": "old.xyz", "recipient_map_new": "440044.xyz"},
+		},
+		{
+			name:   "relayhost",
+			res:    resourceRelayhost(),
+			kind:   "relayhost",
+			config: map[string]interface{}{"hostname": "[smtp.440044.xyz]:587"},
+		},
+		{
+			name:   "transport map",
+			res:    resourceTransport(),
+			kind:   "transport",
+			config: map[string]interface{}{"destination": "440044.xyz", "nexthop": "[smtp.440044.xyz]:587"},
+		},
+		{
+			name:   "tls policy map",
+			res:    resourceTlsPolicyMap(),
+			kind:   "tls-policy-map",
+			config: map[string]interface{}{"dest": "partner.xyz", "policy": "secure"},
+		},
+		{
+			name:   "sieve filter",
+			res:    resourceSieveFilter(),
+			kind:   "filter",
+			config: map[string]interface{}{"username": "demo@440044.xyz", "filter_type": "prefilter", "script_desc": "spam", "script_data": "keep;"},
+		},
+		{
+			name:   "resource",
+			res:    resourceResource(),
+			kind:   "resource",
+			config: map[string]interface{}{"description": "Room 1", "domain": "440044.xyz", "kind": "location"},
+		},
+		{
+			name:   "time-limited alias",
+			res:    resourceTimeLimitedAlias(),
+			kind:   "time_limited_alias",
+			config: map[string]interface{}{"mailbox": "demo@440044.xyz", "validity": 24},
+		},
+		{
+			name:   "rate limit",
+			res:    resourceRateLimit(),
+			kind:   "rl-mbox",
+			config: map[string]interface{}{"mailbox": "demo@440044.xyz", "rate_limit": "10s"},
+		},
+		{
+			name:   "dkim",
+			res:    resourceDkim(),
+			kind:   "dkim",
+			config: map[string]interface{}{"domain": "440044.xyz", "length": 2048},
+		},
+		{
+			name:   "syncjob",
+			res:    resourceSyncjob(),
+			kind:   "syncjob",
+			config: map[string]interface{}{"username": "demo@440044.xyz", "host1": "example.com", "user1": "demo", "password1": "secret"},
+		},
+		{
+			name:   "oauth2 client",
+			res:    resourceOAuth2Client(),
+			kind:   "oauth2-client",
+			config: map[string]interface{}{"redirect_uri": "https://redirect.uri"},
+		},
+		{
+			name: "identity provider keycloak",
+			res:  resourceIdentityProviderKeycloak(),
+			kind: "identity-provider",
+			id:   "identity-provider",
+			config: map[string]interface{}{
+				"server_url":    "https://auth.440044.xyz",
+				"realm":         "mailcow",
+				"client_id":     "mailcow",
+				"client_secret": "secret",
+				"redirect_url":  "https://mail.440044.xyz",
+				"version":       "26.1.3",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := newMockMailcow(t)
+			meta := mock.meta()
+
+			state := testMockApply(t, tc.res, nil, tc.config, meta)
+			id := tc.id
+			if id == "" {
+				id = state.ID
+			}
+			mock.remove(tc.kind, id)
+
+			state = testMockRefresh(t, tc.res, state, meta)
+			if state != nil {
+				t.Fatalf("expected %s to be removed from state, got id %q", tc.name, state.ID)
+			}
+		})
+	}
+}